@@ -1,10 +1,10 @@
 package main
 
 import (
+	"github.com/matheusmortatti/gba-go/lib/bios"
 	"github.com/matheusmortatti/gba-go/lib/drawing"
 	"github.com/matheusmortatti/gba-go/lib/input"
 	"github.com/matheusmortatti/gba-go/lib/interrupts"
-	"github.com/matheusmortatti/gba-go/lib/registers"
 
 	"image/color"
 	"machine"
@@ -22,14 +22,15 @@ var (
 func main() {
 	display.Configure()
 	interrupts.EnableVBlankInterrupt(func() {
-		registers.Interrupt.IFBios.SetBits(1)
 		update()
 		drawing.VSync()
 		drawing.Display()
 	})
 	input.EnablePolling()
 
+	// Sleep between interrupts instead of spinning.
 	for {
+		bios.Halt()
 	}
 }
 
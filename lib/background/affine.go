@@ -0,0 +1,75 @@
+package background
+
+import (
+	"runtime/volatile"
+
+	"github.com/matheusmortatti/gba-go/lib/fixed"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// AffineBackground controls the rotation/scaling registers of BG2 or BG3,
+// which are affine in modes 1 (BG2 only) and 2.
+type AffineBackground struct {
+	pa, pb, pc, pd *volatile.Register16
+	x, y           *volatile.Register32
+}
+
+var (
+	AffineBG2 = &AffineBackground{
+		pa: registers.Lcd.BG2PA, pb: registers.Lcd.BG2PB,
+		pc: registers.Lcd.BG2PC, pd: registers.Lcd.BG2PD,
+		x: registers.Lcd.BG2X, y: registers.Lcd.BG2Y,
+	}
+	AffineBG3 = &AffineBackground{
+		pa: registers.Lcd.BG3PA, pb: registers.Lcd.BG3PB,
+		pc: registers.Lcd.BG3PC, pd: registers.Lcd.BG3PD,
+		x: registers.Lcd.BG3X, y: registers.Lcd.BG3Y,
+	}
+)
+
+// SetTransform rotates the background by angle (0x10000 is a full turn)
+// and zooms it by sx, sy (fixed.One is 1:1, 2*fixed.One doubles the size)
+// around the point cx, cy. That point stays at the same place on screen;
+// use 120, 80 to pivot around the screen center. A zero zoom is treated as
+// 1:1.
+func (bg *AffineBackground) SetTransform(cx, cy int, angle uint16, sx, sy fixed.Fixed) {
+	if sx == 0 {
+		sx = fixed.One
+	}
+	if sy == 0 {
+		sy = fixed.One
+	}
+	// The registers map screen pixels to background pixels, so they hold
+	// the inverse of the rotation and zoom.
+	invX := fixed.One.Div(sx)
+	invY := fixed.One.Div(sy)
+	sin, cos := fixed.Sin(angle), fixed.Cos(angle)
+
+	pa := cos.Mul(invX)
+	pb := -sin.Mul(invX)
+	pc := sin.Mul(invY)
+	pd := cos.Mul(invY)
+
+	// Pick the reference point so that screen (cx, cy) maps to background
+	// (cx, cy): ref = c - P*c.
+	x, y := fixed.Fixed(cx), fixed.Fixed(cy)
+	refX := fixed.FromInt(cx) - (pa*x + pb*y)
+	refY := fixed.FromInt(cy) - (pc*x + pd*y)
+
+	bg.pa.Set(uint16(pa))
+	bg.pb.Set(uint16(pb))
+	bg.pc.Set(uint16(pc))
+	bg.pd.Set(uint16(pd))
+	bg.x.Set(uint32(refX))
+	bg.y.Set(uint32(refY))
+}
+
+// Reset restores the identity transform with no offset.
+func (bg *AffineBackground) Reset() {
+	bg.pa.Set(uint16(fixed.One))
+	bg.pb.Set(0)
+	bg.pc.Set(0)
+	bg.pd.Set(uint16(fixed.One))
+	bg.x.Set(0)
+	bg.y.Set(0)
+}
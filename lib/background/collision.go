@@ -0,0 +1,27 @@
+package background
+
+// SolidFunc reports whether a tile blocks movement.
+type SolidFunc func(tile int) bool
+
+// IsSolidAt reports whether the tile under pixel px, py of the map in
+// screenBlock is solid, as loaded in VRAM. See TileAt.
+func IsSolidAt(screenBlock, px, py int, solid SolidFunc) (bool, error) {
+	e, err := TileAt(screenBlock, px, py)
+	if err != nil {
+		return false, err
+	}
+	return solid(e.TileIndex()), nil
+}
+
+// TileAtPixel returns the tile and attribute bits of the world map at
+// world pixel px, py. It reads the map's TileFunc rather than VRAM, so it
+// works for tiles that are not currently loaded.
+func (m *ScrollingMap) TileAtPixel(px, py int) (tile int, attr uint16) {
+	return m.tileAt(px>>3, py>>3)
+}
+
+// IsSolidAt reports whether the tile under world pixel px, py is solid.
+func (m *ScrollingMap) IsSolidAt(px, py int, solid SolidFunc) bool {
+	tile, _ := m.TileAtPixel(px, py)
+	return solid(tile)
+}
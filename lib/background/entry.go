@@ -0,0 +1,135 @@
+package background
+
+import (
+	"errors"
+	"runtime/volatile"
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/dma"
+)
+
+const (
+	maxTile      = 0x3FF
+	maxPalette   = 15
+	flipHBit     = 1 << 10
+	flipVBit     = 1 << 11
+	paletteShift = 12
+	screenBlocks = 32
+)
+
+var (
+	ErrInvalidTile        = errors.New("background: tile index must be 0-1023")
+	ErrInvalidPalette     = errors.New("background: palette must be 0-15")
+	ErrInvalidScreenBlock = errors.New("background: screen block must be 0-31")
+	ErrOutOfMap           = errors.New("background: position outside the 32x32 map")
+)
+
+// ScreenEntry builds a text background screen entry:
+//
+//	e, err := background.NewScreenEntry().Tile(5).Palette(2).FlipH().Build()
+type ScreenEntry struct {
+	entry uint16
+	err   error
+}
+
+// NewScreenEntry returns an entry for tile 0, palette 0, not flipped.
+func NewScreenEntry() ScreenEntry {
+	return ScreenEntry{}
+}
+
+// Tile sets the tile index, 0-1023.
+func (e ScreenEntry) Tile(n int) ScreenEntry {
+	if n < 0 || n > maxTile {
+		e.err = ErrInvalidTile
+		return e
+	}
+	e.entry = e.entry&^maxTile | uint16(n)
+	return e
+}
+
+// Palette sets the 16-color palette bank, 0-15. It is ignored for 256-color
+// backgrounds.
+func (e ScreenEntry) Palette(p int) ScreenEntry {
+	if p < 0 || p > maxPalette {
+		e.err = ErrInvalidPalette
+		return e
+	}
+	e.entry = e.entry&^(maxPalette<<paletteShift) | uint16(p)<<paletteShift
+	return e
+}
+
+// FlipH mirrors the tile horizontally.
+func (e ScreenEntry) FlipH() ScreenEntry {
+	e.entry |= flipHBit
+	return e
+}
+
+// FlipV mirrors the tile vertically.
+func (e ScreenEntry) FlipV() ScreenEntry {
+	e.entry |= flipVBit
+	return e
+}
+
+// Build returns the raw entry, or the first error from Tile or Palette.
+func (e ScreenEntry) Build() (uint16, error) {
+	return e.entry, e.err
+}
+
+// TileIndex returns the tile index of the entry.
+func (e ScreenEntry) TileIndex() int {
+	return int(e.entry & maxTile)
+}
+
+// Attr returns the palette and flip bits of the entry, in the layout
+// TileFunc uses.
+func (e ScreenEntry) Attr() uint16 {
+	return e.entry &^ maxTile
+}
+
+// SetEntry writes e at tile x, y of the 32x32 map in screenBlock.
+func SetEntry(screenBlock, x, y int, e ScreenEntry) error {
+	entry, err := e.Build()
+	if err != nil {
+		return err
+	}
+	if screenBlock < 0 || screenBlock >= screenBlocks {
+		return ErrInvalidScreenBlock
+	}
+	if x < 0 || x >= mapTiles || y < 0 || y >= mapTiles {
+		return ErrOutOfMap
+	}
+	addr := uintptr(vramBase + screenBlock*screenBlockSize + (y*mapTiles+x)*2)
+	volatile.StoreUint16((*uint16)(unsafe.Pointer(addr)), entry)
+	return nil
+}
+
+// TileAt returns the entry under pixel px, py of the 32x32 map in
+// screenBlock, read back from VRAM. The coordinates wrap every 256 pixels
+// like the map itself.
+func TileAt(screenBlock, px, py int) (ScreenEntry, error) {
+	if screenBlock < 0 || screenBlock >= screenBlocks {
+		return ScreenEntry{}, ErrInvalidScreenBlock
+	}
+	x, y := px>>3&(mapTiles-1), py>>3&(mapTiles-1)
+	addr := uintptr(vramBase + screenBlock*screenBlockSize + (y*mapTiles+x)*2)
+	return ScreenEntry{entry: volatile.LoadUint16((*uint16)(unsafe.Pointer(addr)))}, nil
+}
+
+// FillScreenBlock sets every entry of the 32x32 map in screenBlock to e
+// with a single DMA3 transfer.
+func FillScreenBlock(screenBlock int, e ScreenEntry) error {
+	entry, err := e.Build()
+	if err != nil {
+		return err
+	}
+	if screenBlock < 0 || screenBlock >= screenBlocks {
+		return ErrInvalidScreenBlock
+	}
+	dst := unsafe.Pointer(uintptr(vramBase + screenBlock*screenBlockSize))
+	return dma.Channel3.Fill32(dst, uint32(entry)<<16|uint32(entry), screenBlockSize/4)
+}
+
+// ClearScreenBlock sets every entry of the map in screenBlock to tile 0.
+func ClearScreenBlock(screenBlock int) error {
+	return FillScreenBlock(screenBlock, NewScreenEntry())
+}
@@ -0,0 +1,51 @@
+package background
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/dma"
+)
+
+var (
+	ErrInvalidMapSize = errors.New("background: map must be 32 or 64 tiles wide and high")
+	ErrMapLength      = errors.New("background: tile data does not match the map size")
+)
+
+// LoadMap copies a map of packed screen entries (tile index and attribute
+// bits), stored row by row, into VRAM starting at screenBlock. mapWidth and
+// mapHeight are in tiles and must each be 32 or 64.
+//
+// Maps larger than 32x32 span several screen blocks, each holding a 32x32
+// quarter: a 64-wide map puts its right half in the next block, and a
+// 64-high map its bottom half after the top one. LoadMap does that split,
+// so tiles can come straight from a map editor export.
+func LoadMap(screenBlock int, tiles []uint16, mapWidth, mapHeight int) error {
+	if !validMapSide(mapWidth) || !validMapSide(mapHeight) {
+		return ErrInvalidMapSize
+	}
+	if len(tiles) != mapWidth*mapHeight {
+		return ErrMapLength
+	}
+	blocksWide := mapWidth / mapTiles
+	blocks := blocksWide * mapHeight / mapTiles
+	if screenBlock < 0 || screenBlock+blocks > screenBlocks {
+		return ErrInvalidScreenBlock
+	}
+
+	for y := 0; y < mapHeight; y++ {
+		for bx := 0; bx < blocksWide; bx++ {
+			block := screenBlock + y/mapTiles*blocksWide + bx
+			dst := uintptr(vramBase + block*screenBlockSize + y%mapTiles*mapTiles*2)
+			src := &tiles[y*mapWidth+bx*mapTiles]
+			if err := dma.Channel3.Copy16(unsafe.Pointer(src), unsafe.Pointer(dst), mapTiles); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validMapSide(n int) bool {
+	return n == mapTiles || n == 2*mapTiles
+}
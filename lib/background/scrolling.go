@@ -0,0 +1,94 @@
+package background
+
+import (
+	"runtime/volatile"
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const (
+	vramBase        = 0x06000000
+	screenBlockSize = 0x800
+	mapTiles        = 32 // a 32x32 tile map wraps every 256 pixels
+
+	viewCols = 240/8 + 1 // tiles touched by a screen-wide row at any scroll
+	viewRows = 160/8 + 1
+)
+
+// TileFunc returns the tile index and attribute bits (palette, flips) of
+// the world map at tile column col, row row.
+type TileFunc func(col, row int) (tile int, attr uint16)
+
+// ScrollingMap shows a world larger than the hardware map on a 32x32 text
+// background. The map wraps around, so as the view moves only the tile
+// columns and rows that scroll into view are written, just off screen.
+type ScrollingMap struct {
+	bg          int
+	screenBlock int
+	tileAt      TileFunc
+
+	col, row int // world tile at the top-left of the loaded area
+	loaded   bool
+}
+
+// NewScrollingMap streams tiles from tileAt into screen block screenBlock
+// (0-31), which background bg (0-3) must be configured to use as a 32x32
+// map.
+func NewScrollingMap(bg, screenBlock int, tileAt TileFunc) *ScrollingMap {
+	return &ScrollingMap{bg: bg, screenBlock: screenBlock, tileAt: tileAt}
+}
+
+// ScrollTo moves the view so world pixel x, y is at the top-left of the
+// screen, loading any tiles that become visible.
+func (m *ScrollingMap) ScrollTo(x, y int) {
+	col, row := x>>3, y>>3 // floor, also for negative coordinates
+	dc, dr := col-m.col, row-m.row
+
+	switch {
+	case !m.loaded || abs(dc) >= viewCols || abs(dr) >= viewRows:
+		m.loadArea(col, row, viewCols, viewRows)
+		m.loaded = true
+	default:
+		if dc > 0 {
+			m.loadArea(m.col+viewCols, row, dc, viewRows)
+		} else if dc < 0 {
+			m.loadArea(col, row, -dc, viewRows)
+		}
+		if dr > 0 {
+			m.loadArea(col, m.row+viewRows, viewCols, dr)
+		} else if dr < 0 {
+			m.loadArea(col, row, viewCols, -dr)
+		}
+	}
+	m.col, m.row = col, row
+
+	if m.bg >= 0 && m.bg < len(registers.BGOffset) {
+		registers.BGOffset[m.bg].HOFS.Set(uint16(x))
+		registers.BGOffset[m.bg].VOFS.Set(uint16(y))
+	}
+}
+
+// Reload rewrites every visible tile, e.g. after the world data changed.
+func (m *ScrollingMap) Reload() {
+	m.loadArea(m.col, m.row, viewCols, viewRows)
+}
+
+func (m *ScrollingMap) loadArea(col, row, cols, rows int) {
+	base := uintptr(vramBase + m.screenBlock*screenBlockSize)
+	for r := row; r < row+rows; r++ {
+		for c := col; c < col+cols; c++ {
+			tile, attr := m.tileAt(c, r)
+			i := (r&(mapTiles-1))*mapTiles + c&(mapTiles-1)
+			entry := uint16(tile)&0x3FF | attr&^0x3FF
+			volatile.StoreUint16((*uint16)(unsafe.Pointer(base+uintptr(i*2))), entry)
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,46 @@
+package bios
+
+import (
+	"unsafe"
+)
+
+// ObjAffineSource is the input of ObjAffineSet. SX and SY are 8.8
+// fixed-point ratios where 0x100 is 1:1 and larger values shrink the
+// sprite. Alpha is the rotation angle, a full turn being 0x10000 (only the
+// upper 8 bits are used).
+type ObjAffineSource struct {
+	SX, SY int16
+	Alpha  uint16
+	_      uint16
+}
+
+// BgAffineSource is the input of BgAffineSet. TexX/TexY is the point of the
+// background (19.8 fixed-point) that ends up at screen pixel ScrX/ScrY,
+// which is also the center of rotation. SX, SY and Alpha are the same as in
+// ObjAffineSource.
+type BgAffineSource struct {
+	TexX, TexY int32
+	ScrX, ScrY int16
+	SX, SY     int16
+	Alpha      uint16
+	_          uint16
+}
+
+// BgAffineDest matches the BGxPA-BGxPD and BGxX/BGxY register layout, so
+// it can be copied to the registers as is.
+type BgAffineDest struct {
+	PA, PB, PC, PD int16
+	DX, DY         int32
+}
+
+// ObjAffineSet computes count rotation/scaling matrices from src. Each
+// matrix is written as PA, PB, PC, PD halfwords stride bytes apart: 2 for a
+// plain array, 8 to write straight into OAM.
+func ObjAffineSet(src *ObjAffineSource, dst unsafe.Pointer, count, stride int) {
+	swiObjAffineSet(unsafe.Pointer(src), dst, uint32(count), uint32(stride))
+}
+
+// BgAffineSet computes count background transforms from src into dst.
+func BgAffineSet(src *BgAffineSource, dst *BgAffineDest, count int) {
+	swiBgAffineSet(unsafe.Pointer(src), unsafe.Pointer(dst), uint32(count))
+}
@@ -1,9 +1,34 @@
 package bios
 
-import (
-	"device/arm"
-)
-
 func VBlankIntrWait() {
-	arm.Asm("swi 0x50000" /* Instr_VBlankIntrWait */)
+	swiVBlankIntrWait()
+}
+
+// Halt sleeps the CPU until any interrupt enabled in IE is requested,
+// saving battery compared to busy-waiting.
+func Halt() {
+	swiHalt()
+}
+
+// Stop turns off the CPU, sound and video until a keypad, serial or
+// game pak interrupt occurs. The display should be blanked beforehand.
+func Stop() {
+	swiStop()
+}
+
+// IntrWait sleeps until one of the interrupts in flags (IE bit layout) is
+// requested. If clearOld is set, requests that already happened are
+// discarded first. The interrupt handler must set the flag in IFBios.
+func IntrWait(clearOld bool, flags uint16) {
+	var discard uint32
+	if clearOld {
+		discard = 1
+	}
+	swiIntrWait(discard, uint32(flags))
+}
+
+// SoftReset restarts the game from the ROM entry point, clearing the top
+// of IWRAM used by the BIOS and the stacks. It does not return.
+func SoftReset() {
+	swiSoftReset()
 }
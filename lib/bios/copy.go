@@ -0,0 +1,44 @@
+package bios
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// Flags for CpuSet and CpuFastSet.
+const (
+	CpuSetFill = 1 << 24 // repeat the first unit of src instead of copying
+	CpuSet32   = 1 << 26 // transfer words instead of halfwords (CpuSet only)
+)
+
+const maxCpuSetCount = 1<<21 - 1
+
+var (
+	ErrInvalidCount = errors.New("bios: transfer count out of range")
+	ErrNotBlock     = errors.New("bios: CpuFastSet count must be a multiple of 8 words")
+)
+
+// CpuSet copies or fills count halfwords (or words with CpuSet32) from src
+// to dst without using a DMA channel. Both addresses must be aligned to the
+// unit size.
+func CpuSet(src, dst unsafe.Pointer, count int, flags uint32) error {
+	if count < 0 || count > maxCpuSetCount {
+		return ErrInvalidCount
+	}
+	swiCpuSet(src, dst, uint32(count)|flags&(CpuSetFill|CpuSet32))
+	return nil
+}
+
+// CpuFastSet copies or fills count words from src to dst in 32-byte
+// blocks, faster than CpuSet. count must be a multiple of 8 and both
+// addresses word-aligned.
+func CpuFastSet(src, dst unsafe.Pointer, count int, flags uint32) error {
+	if count < 0 || count > maxCpuSetCount {
+		return ErrInvalidCount
+	}
+	if count%8 != 0 {
+		return ErrNotBlock
+	}
+	swiCpuFastSet(src, dst, uint32(count)|flags&CpuSetFill)
+	return nil
+}
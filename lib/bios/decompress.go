@@ -0,0 +1,41 @@
+package bios
+
+import (
+	"unsafe"
+)
+
+// The decompression calls expect src to be word-aligned and to start with
+// a 4-byte header:
+//
+//	bits 0-3   reserved (Huffman: bits per data unit, 4 or 8)
+//	bits 4-7   compression type: 1 LZ77, 2 Huffman, 3 run-length
+//	bits 8-31  size of the decompressed data in bytes
+//
+// The VRAM variants write 16 bits at a time, so they are also safe for
+// VRAM, OAM and palette RAM; the WRAM variants write bytes and are faster.
+
+// LZ77UnCompWRAM decompresses LZ77 data from src into work RAM at dst.
+func LZ77UnCompWRAM(src, dst unsafe.Pointer) {
+	swiLZ77UnCompWRAM(src, dst)
+}
+
+// LZ77UnCompVRAM decompresses LZ77 data from src into VRAM at dst.
+func LZ77UnCompVRAM(src, dst unsafe.Pointer) {
+	swiLZ77UnCompVRAM(src, dst)
+}
+
+// HuffUnComp decompresses Huffman data from src into dst. dst must be
+// word-aligned as the output is written 32 bits at a time.
+func HuffUnComp(src, dst unsafe.Pointer) {
+	swiHuffUnComp(src, dst)
+}
+
+// RLUnCompWRAM decompresses run-length data from src into work RAM at dst.
+func RLUnCompWRAM(src, dst unsafe.Pointer) {
+	swiRLUnCompWRAM(src, dst)
+}
+
+// RLUnCompVRAM decompresses run-length data from src into VRAM at dst.
+func RLUnCompVRAM(src, dst unsafe.Pointer) {
+	swiRLUnCompVRAM(src, dst)
+}
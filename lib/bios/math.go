@@ -0,0 +1,17 @@
+package bios
+
+// Div divides num by denom, rounding towards zero like Go's / and %. The
+// BIOS call locks up on a zero denominator, so Div panics instead, matching
+// Go's integer division.
+func Div(num, denom int32) (quotient, remainder int32) {
+	if denom == 0 {
+		panic("bios: division by zero")
+	}
+	quotient = swiDiv(num, denom)
+	return quotient, num - quotient*denom
+}
+
+// Sqrt returns the integer square root of x, rounded down.
+func Sqrt(x uint32) uint16 {
+	return uint16(swiSqrt(x))
+}
@@ -0,0 +1,55 @@
+// BIOS calls as small assembly functions. The arguments arrive in r0-r3
+// by the ARM calling convention, which also lets the callee change r0-r3
+// and r12, so the compiler saves anything it keeps live there. That is
+// what the BIOS needs: it reads its arguments from fixed registers and
+// freely overwrites them. The code is built in ARM mode, so SWI numbers
+// take the ARM form 0xNN0000.
+
+#include <stdint.h>
+
+#define BIOS_CALL(num) \
+	__asm__("swi " #num "\n\tbx lr")
+
+__attribute__((naked)) void bios_vblank_intr_wait(void) { BIOS_CALL(0x050000); }
+
+__attribute__((naked)) void bios_halt(void) { BIOS_CALL(0x020000); }
+
+__attribute__((naked)) void bios_stop(void) { BIOS_CALL(0x030000); }
+
+__attribute__((naked)) void bios_intr_wait(uint32_t discard, uint32_t flags) { BIOS_CALL(0x040000); }
+
+// The reset target is chosen by the byte at 0x03007FFA; 0 means ROM. The
+// call does not return.
+__attribute__((naked)) void bios_soft_reset(void) {
+	__asm__(
+		"msr cpsr_fc, #0x9F\n\t"
+		"mov r0, #0\n\t"
+		"mov r1, #0x03000000\n\t"
+		"orr r1, r1, #0x7F00\n\t"
+		"orr r1, r1, #0xFA\n\t"
+		"strb r0, [r1]\n\t"
+		"swi 0x000000");
+}
+
+__attribute__((naked)) void bios_lz77_uncomp_wram(const void *src, void *dst) { BIOS_CALL(0x110000); }
+
+__attribute__((naked)) void bios_lz77_uncomp_vram(const void *src, void *dst) { BIOS_CALL(0x120000); }
+
+__attribute__((naked)) void bios_huff_uncomp(const void *src, void *dst) { BIOS_CALL(0x130000); }
+
+__attribute__((naked)) void bios_rl_uncomp_wram(const void *src, void *dst) { BIOS_CALL(0x140000); }
+
+__attribute__((naked)) void bios_rl_uncomp_vram(const void *src, void *dst) { BIOS_CALL(0x150000); }
+
+__attribute__((naked)) void bios_obj_affine_set(const void *src, void *dst, uint32_t count, uint32_t stride) { BIOS_CALL(0x0F0000); }
+
+__attribute__((naked)) void bios_bg_affine_set(const void *src, void *dst, uint32_t count) { BIOS_CALL(0x0E0000); }
+
+// Div leaves the quotient in r0, where it is also returned.
+__attribute__((naked)) int32_t bios_div(int32_t num, int32_t denom) { BIOS_CALL(0x060000); }
+
+__attribute__((naked)) uint32_t bios_sqrt(uint32_t x) { BIOS_CALL(0x080000); }
+
+__attribute__((naked)) void bios_cpu_set(const void *src, void *dst, uint32_t control) { BIOS_CALL(0x0B0000); }
+
+__attribute__((naked)) void bios_cpu_fast_set(const void *src, void *dst, uint32_t control) { BIOS_CALL(0x0C0000); }
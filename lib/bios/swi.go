@@ -0,0 +1,75 @@
+package bios
+
+// The SWIs are called through the functions in swi.c rather than inline
+// assembly: arm.AsmFull cannot pin operands to r0-r3 or declare the
+// registers the BIOS overwrites.
+
+/*
+#include <stdint.h>
+
+void bios_vblank_intr_wait(void);
+void bios_halt(void);
+void bios_stop(void);
+void bios_intr_wait(uint32_t discard, uint32_t flags);
+void bios_soft_reset(void);
+void bios_lz77_uncomp_wram(const void *src, void *dst);
+void bios_lz77_uncomp_vram(const void *src, void *dst);
+void bios_huff_uncomp(const void *src, void *dst);
+void bios_rl_uncomp_wram(const void *src, void *dst);
+void bios_rl_uncomp_vram(const void *src, void *dst);
+void bios_obj_affine_set(const void *src, void *dst, uint32_t count, uint32_t stride);
+void bios_bg_affine_set(const void *src, void *dst, uint32_t count);
+int32_t bios_div(int32_t num, int32_t denom);
+uint32_t bios_sqrt(uint32_t x);
+void bios_cpu_set(const void *src, void *dst, uint32_t control);
+void bios_cpu_fast_set(const void *src, void *dst, uint32_t control);
+*/
+import "C"
+
+import "unsafe"
+
+func swiVBlankIntrWait() { C.bios_vblank_intr_wait() }
+
+func swiHalt() { C.bios_halt() }
+
+func swiStop() { C.bios_stop() }
+
+func swiIntrWait(discard, flags uint32) {
+	C.bios_intr_wait(C.uint32_t(discard), C.uint32_t(flags))
+}
+
+func swiSoftReset() { C.bios_soft_reset() }
+
+func swiLZ77UnCompWRAM(src, dst unsafe.Pointer) { C.bios_lz77_uncomp_wram(src, dst) }
+
+func swiLZ77UnCompVRAM(src, dst unsafe.Pointer) { C.bios_lz77_uncomp_vram(src, dst) }
+
+func swiHuffUnComp(src, dst unsafe.Pointer) { C.bios_huff_uncomp(src, dst) }
+
+func swiRLUnCompWRAM(src, dst unsafe.Pointer) { C.bios_rl_uncomp_wram(src, dst) }
+
+func swiRLUnCompVRAM(src, dst unsafe.Pointer) { C.bios_rl_uncomp_vram(src, dst) }
+
+func swiObjAffineSet(src, dst unsafe.Pointer, count, stride uint32) {
+	C.bios_obj_affine_set(src, dst, C.uint32_t(count), C.uint32_t(stride))
+}
+
+func swiBgAffineSet(src, dst unsafe.Pointer, count uint32) {
+	C.bios_bg_affine_set(src, dst, C.uint32_t(count))
+}
+
+func swiDiv(num, denom int32) int32 {
+	return int32(C.bios_div(C.int32_t(num), C.int32_t(denom)))
+}
+
+func swiSqrt(x uint32) uint32 {
+	return uint32(C.bios_sqrt(C.uint32_t(x)))
+}
+
+func swiCpuSet(src, dst unsafe.Pointer, control uint32) {
+	C.bios_cpu_set(src, dst, C.uint32_t(control))
+}
+
+func swiCpuFastSet(src, dst unsafe.Pointer, control uint32) {
+	C.bios_cpu_fast_set(src, dst, C.uint32_t(control))
+}
@@ -0,0 +1,78 @@
+package camera
+
+import (
+	"github.com/matheusmortatti/gba-go/lib/geom"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const (
+	ScreenWidth  = 240
+	ScreenHeight = 160
+)
+
+// Camera is a view into a world, positioned by the world coordinates of
+// the top-left screen pixel.
+type Camera struct {
+	X, Y   int
+	bounds geom.Rect
+}
+
+// SetBounds limits the camera so the view never leaves bounds. An empty
+// Rect removes the limit.
+func (c *Camera) SetBounds(bounds geom.Rect) {
+	c.bounds = bounds
+	c.clamp()
+}
+
+// MoveTo places the top-left corner of the view at x, y.
+func (c *Camera) MoveTo(x, y int) {
+	c.X, c.Y = x, y
+	c.clamp()
+}
+
+// Follow moves the camera just enough to keep the target inside deadzone,
+// given in screen coordinates. Inside the deadzone the camera stays still.
+func (c *Camera) Follow(targetX, targetY int, deadzone geom.Rect) {
+	sx, sy := targetX-c.X, targetY-c.Y
+	if sx < deadzone.X {
+		c.X = targetX - deadzone.X
+	} else if sx >= deadzone.Right() {
+		c.X = targetX - deadzone.Right() + 1
+	}
+	if sy < deadzone.Y {
+		c.Y = targetY - deadzone.Y
+	} else if sy >= deadzone.Bottom() {
+		c.Y = targetY - deadzone.Bottom() + 1
+	}
+	c.clamp()
+}
+
+// Apply writes the camera position to the scroll registers of background
+// bg (0-3). Only text backgrounds use these registers.
+func (c *Camera) Apply(bg int) {
+	if bg < 0 || bg >= len(registers.BGOffset) {
+		return
+	}
+	registers.BGOffset[bg].HOFS.Set(uint16(c.X))
+	registers.BGOffset[bg].VOFS.Set(uint16(c.Y))
+}
+
+func (c *Camera) clamp() {
+	if c.bounds.IsEmpty() {
+		return
+	}
+	c.X = clampAxis(c.X, c.bounds.X, c.bounds.Right()-ScreenWidth)
+	c.Y = clampAxis(c.Y, c.bounds.Y, c.bounds.Bottom()-ScreenHeight)
+}
+
+// clampAxis keeps v in [min, max]. If the world is smaller than the screen
+// the view sticks to min.
+func clampAxis(v, min, max int) int {
+	if v > max {
+		v = max
+	}
+	if v < min {
+		v = min
+	}
+	return v
+}
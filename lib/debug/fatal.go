@@ -0,0 +1,158 @@
+package debug
+
+import (
+	"runtime/volatile"
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// The fatal screen only touches IO registers and VRAM directly so it keeps
+// working when interrupts, DMA or other packages are in a bad state.
+
+const (
+	screenWidth  = 240
+	screenHeight = 160
+	vramBase     = 0x06000000
+
+	mode3     = 3
+	enableBG2 = 1 << 10
+
+	scale      = 2
+	cellWidth  = 4 * scale
+	cellHeight = 6 * scale
+	margin     = 8
+
+	backgroundColor = 0x0010 // dark red
+	textColor       = 0x7FFF // white
+)
+
+var cursorX, cursorY int
+
+// Fatal switches to Mode 3, paints a red error screen showing msg and halts
+// forever.
+func Fatal(msg string) {
+	begin()
+	drawString(msg)
+	halt()
+}
+
+// FatalRegs is like Fatal but also dumps the main display and interrupt
+// registers below the message.
+func FatalRegs(msg string) {
+	// Capture the registers before begin overwrites DISPCNT and IME.
+	regs := [...]struct {
+		name  string
+		value uint16
+	}{
+		{"DISPCNT  ", registers.Lcd.DISPCNT.Get()},
+		{"DISPSTAT ", registers.Lcd.DISPSTAT.Get()},
+		{"VCOUNT   ", registers.Lcd.VCOUNT.Get()},
+		{"IE       ", registers.Interrupt.IE.Get()},
+		{"IF       ", registers.Interrupt.IF.Get()},
+		{"IME      ", registers.Interrupt.IME.Get()},
+	}
+
+	begin()
+	drawString(msg)
+	newline()
+	newline()
+	for _, r := range regs {
+		dump(r.name, r.value)
+	}
+	halt()
+}
+
+func begin() {
+	registers.Interrupt.IME.Set(0)
+
+	// Stop DMA first, as HBlank transfers (waves, mode 7) keep rewriting the
+	// scroll and affine registers reset below.
+	dma := registers.DmaTransferChannels
+	for _, cnt := range [...]*volatile.Register16{dma.DMA0CNT_H, dma.DMA1CNT_H, dma.DMA2CNT_H, dma.DMA3CNT_H} {
+		cnt.Set(0)
+	}
+
+	// Undo fades, mosaic and BG2 transforms that would hide the message.
+	lcd := registers.Lcd
+	lcd.BLDCNT.Set(0)
+	lcd.BLDY.Set(0)
+	lcd.MOSAIC.Set(0)
+	lcd.BG2CNT.Set(0)
+	lcd.BG2PA.Set(0x100)
+	lcd.BG2PB.Set(0)
+	lcd.BG2PC.Set(0)
+	lcd.BG2PD.Set(0x100)
+	lcd.BG2X.Set(0)
+	lcd.BG2Y.Set(0)
+
+	lcd.DISPCNT.Set(mode3 | enableBG2)
+	for i := 0; i < screenWidth*screenHeight; i++ {
+		setPixel(i%screenWidth, i/screenWidth, backgroundColor)
+	}
+	cursorX, cursorY = margin, margin
+}
+
+func halt() {
+	for {
+	}
+}
+
+func dump(name string, value uint16) {
+	drawString(name)
+	drawString("0x")
+	for shift := 12; shift >= 0; shift -= 4 {
+		drawChar("0123456789ABCDEF"[(value>>shift)&0xF])
+	}
+	newline()
+}
+
+func drawString(s string) {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			newline()
+			continue
+		}
+		if cursorX+cellWidth > screenWidth-margin {
+			newline()
+		}
+		drawChar(s[i])
+	}
+}
+
+func newline() {
+	cursorX = margin
+	cursorY += cellHeight
+}
+
+func drawChar(c byte) {
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	if c < 0x20 || c >= 0x20+byte(len(glyphs)) {
+		c = '?'
+	}
+	if cursorY+cellHeight > screenHeight {
+		return
+	}
+
+	glyph := glyphs[c-0x20]
+	for row := 0; row < 5; row++ {
+		for col := 0; col < 3; col++ {
+			if glyph&(1<<(14-row*3-col)) == 0 {
+				continue
+			}
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					setPixel(cursorX+col*scale+dx, cursorY+row*scale+dy, textColor)
+				}
+			}
+		}
+	}
+	cursorX += cellWidth
+}
+
+func setPixel(x, y int, color uint16) {
+	addr := uintptr(vramBase + (y*screenWidth+x)*2)
+	volatile.StoreUint16((*uint16)(unsafe.Pointer(addr)), color)
+}
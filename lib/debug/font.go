@@ -0,0 +1,70 @@
+package debug
+
+// glyphs is a 3x5 font covering ASCII 0x20-0x5F. Each glyph stores five
+// rows of three pixels, top row first, leftmost pixel in the high bit.
+var glyphs = [64]uint16{
+	0b000_000_000_000_000, // space
+	0b010_010_010_000_010, // !
+	0b101_101_000_000_000, // "
+	0b101_111_101_111_101, // #
+	0b011_110_010_011_110, // $
+	0b101_001_010_100_101, // %
+	0b010_101_010_101_011, // &
+	0b010_010_000_000_000, // '
+	0b001_010_010_010_001, // (
+	0b100_010_010_010_100, // )
+	0b000_101_010_101_000, // *
+	0b000_010_111_010_000, // +
+	0b000_000_000_010_100, // ,
+	0b000_000_111_000_000, // -
+	0b000_000_000_000_010, // .
+	0b001_001_010_100_100, // /
+	0b111_101_101_101_111, // 0
+	0b010_110_010_010_111, // 1
+	0b111_001_111_100_111, // 2
+	0b111_001_111_001_111, // 3
+	0b101_101_111_001_001, // 4
+	0b111_100_111_001_111, // 5
+	0b111_100_111_101_111, // 6
+	0b111_001_001_001_001, // 7
+	0b111_101_111_101_111, // 8
+	0b111_101_111_001_111, // 9
+	0b000_010_000_010_000, // :
+	0b000_010_000_010_100, // ;
+	0b001_010_100_010_001, // <
+	0b000_111_000_111_000, // =
+	0b100_010_001_010_100, // >
+	0b111_001_010_000_010, // ?
+	0b111_101_111_100_111, // @
+	0b010_101_111_101_101, // A
+	0b110_101_110_101_110, // B
+	0b011_100_100_100_011, // C
+	0b110_101_101_101_110, // D
+	0b111_100_110_100_111, // E
+	0b111_100_110_100_100, // F
+	0b011_100_101_101_011, // G
+	0b101_101_111_101_101, // H
+	0b111_010_010_010_111, // I
+	0b001_001_001_101_010, // J
+	0b101_101_110_101_101, // K
+	0b100_100_100_100_111, // L
+	0b101_111_111_101_101, // M
+	0b110_101_101_101_101, // N
+	0b010_101_101_101_010, // O
+	0b110_101_110_100_100, // P
+	0b010_101_101_110_011, // Q
+	0b110_101_110_101_101, // R
+	0b011_100_010_001_110, // S
+	0b111_010_010_010_010, // T
+	0b101_101_101_101_111, // U
+	0b101_101_101_101_010, // V
+	0b101_101_111_111_101, // W
+	0b101_101_010_101_101, // X
+	0b101_101_010_010_010, // Y
+	0b111_001_010_100_111, // Z
+	0b110_100_100_100_110, // [
+	0b100_100_010_001_001, // \
+	0b011_001_001_001_011, // ]
+	0b010_101_000_000_000, // ^
+	0b000_000_000_000_111, // _
+}
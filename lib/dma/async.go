@@ -0,0 +1,63 @@
+package dma
+
+import (
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/interrupts"
+)
+
+const irqBit = 1 << 14
+
+// StartCopyAsync arms a copy of count words from src to dst that runs at
+// the next trigger of timing, and returns without waiting. done runs from
+// the DMA interrupt once the transfer has finished; poll IsBusy instead if
+// done is nil. src and dst must stay alive until then. While done is
+// pending the channel cannot be restarted: other transfers on it fail with
+// ErrBusy, and Stop cancels the transfer without calling done.
+//
+// The CPU is paused while the DMA owns the bus, so this mostly pays off
+// with VBlank or HBlank timing, where the call returns long before the
+// transfer runs.
+func (c *Channel) StartCopyAsync(src, dst unsafe.Pointer, count int, timing DMATiming, done func()) error {
+	if count <= 0 || count > c.maxCount {
+		return ErrInvalidCount
+	}
+	if err := c.claim(); err != nil {
+		return err
+	}
+	return c.startAsync(uintptr(src), uintptr(dst), count, srcIncrement|destIncrement|transfer32, timing, done)
+}
+
+// StartFillAsync arms writing value to count words at dst, like
+// StartCopyAsync.
+func (c *Channel) StartFillAsync(dst unsafe.Pointer, value uint32, count int, timing DMATiming, done func()) error {
+	if count <= 0 || count > c.maxCount {
+		return ErrInvalidCount
+	}
+	if err := c.claim(); err != nil {
+		return err
+	}
+	c.setFill(value)
+	return c.startAsync(uintptr(unsafe.Pointer(&c.fill)), uintptr(dst), count, srcFixed|destIncrement|transfer32, timing, done)
+}
+
+// startAsync starts a claimed channel with a validated count.
+func (c *Channel) startAsync(src, dst uintptr, count int, control uint16, timing DMATiming, done func()) error {
+	c.done = done
+	if done != nil {
+		if err := interrupts.EnableInterrupt(c.irq, c.handleDone); err != nil {
+			c.done = nil
+			return err
+		}
+		control |= irqBit
+	}
+	c.start(src, dst, count, control, timing)
+	return nil
+}
+
+func (c *Channel) handleDone() {
+	if fn := c.done; fn != nil {
+		c.done = nil
+		fn()
+	}
+}
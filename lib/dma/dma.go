@@ -0,0 +1,189 @@
+package dma
+
+import (
+	"errors"
+	"runtime/volatile"
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/interrupts"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// DMATiming selects what starts a transfer.
+type DMATiming uint16
+
+const (
+	TimingImmediate DMATiming = iota
+	TimingVBlank
+	TimingHBlank
+	TimingSpecial // Sound FIFO for DMA1/2, video capture for DMA3
+)
+
+const (
+	destIncrement = 0 << 5
+	destFixed     = 2 << 5
+	srcIncrement  = 0 << 7
+	srcFixed      = 2 << 7
+	transfer32    = 1 << 10
+	enableBit     = 1 << 15
+	timingShift   = 12
+)
+
+var (
+	ErrInvalidCount = errors.New("dma: count out of range for channel")
+	ErrBusy         = errors.New("dma: channel has an async transfer in flight")
+)
+
+type Channel struct {
+	sad       *volatile.Register32 // Source Address
+	dad       *volatile.Register32 // Destination Address
+	cntL      *volatile.Register16 // Word Count
+	cntH      *volatile.Register16 // Control
+	maxCount  int
+	fill      uint32 // Fill source; must outlive the transfer
+	irq       interrupts.IRQSource
+	done      func()
+	remaining int // words left in a counted sound FIFO transfer
+}
+
+// Channels 0-2 can move up to 0x4000 units per transfer, channel 3 up to
+// 0x10000.
+var (
+	Channel0 = &Channel{
+		sad:      registers.DmaTransferChannels.DMA0SAD,
+		dad:      registers.DmaTransferChannels.DMA0DAD,
+		cntL:     registers.DmaTransferChannels.DMA0CNT_L,
+		cntH:     registers.DmaTransferChannels.DMA0CNT_H,
+		irq:      interrupts.DMA0,
+		maxCount: 0x4000,
+	}
+	Channel1 = &Channel{
+		sad:      registers.DmaTransferChannels.DMA1SAD,
+		dad:      registers.DmaTransferChannels.DMA1DAD,
+		cntL:     registers.DmaTransferChannels.DMA1CNT_L,
+		cntH:     registers.DmaTransferChannels.DMA1CNT_H,
+		irq:      interrupts.DMA1,
+		maxCount: 0x4000,
+	}
+	Channel2 = &Channel{
+		sad:      registers.DmaTransferChannels.DMA2SAD,
+		dad:      registers.DmaTransferChannels.DMA2DAD,
+		cntL:     registers.DmaTransferChannels.DMA2CNT_L,
+		cntH:     registers.DmaTransferChannels.DMA2CNT_H,
+		irq:      interrupts.DMA2,
+		maxCount: 0x4000,
+	}
+	Channel3 = &Channel{
+		sad:      registers.DmaTransferChannels.DMA3SAD,
+		dad:      registers.DmaTransferChannels.DMA3DAD,
+		cntL:     registers.DmaTransferChannels.DMA3CNT_L,
+		cntH:     registers.DmaTransferChannels.DMA3CNT_H,
+		irq:      interrupts.DMA3,
+		maxCount: 0x10000,
+	}
+)
+
+// Copy16, Copy32, Fill16 and Fill32 run immediately and return once the
+// transfer is done. Use Start32 or the async variants for transfers that
+// wait for VBlank or HBlank.
+
+// Copy16 copies count halfwords from src to dst.
+func (c *Channel) Copy16(src, dst unsafe.Pointer, count int) error {
+	return c.transfer(uintptr(src), uintptr(dst), count, srcIncrement|destIncrement)
+}
+
+// Copy32 copies count words from src to dst.
+func (c *Channel) Copy32(src, dst unsafe.Pointer, count int) error {
+	return c.transfer(uintptr(src), uintptr(dst), count, srcIncrement|destIncrement|transfer32)
+}
+
+// Fill16 writes value to count halfwords starting at dst.
+func (c *Channel) Fill16(dst unsafe.Pointer, value uint16, count int) error {
+	if err := c.claim(); err != nil {
+		return err
+	}
+	c.setFill(uint32(value)<<16 | uint32(value))
+	return c.transfer(uintptr(unsafe.Pointer(&c.fill)), uintptr(dst), count, srcFixed|destIncrement)
+}
+
+// Fill32 writes value to count words starting at dst.
+func (c *Channel) Fill32(dst unsafe.Pointer, value uint32, count int) error {
+	if err := c.claim(); err != nil {
+		return err
+	}
+	c.setFill(value)
+	return c.transfer(uintptr(unsafe.Pointer(&c.fill)), uintptr(dst), count, srcFixed|destIncrement|transfer32)
+}
+
+// Start32 arms a copy of count words from src to dst that runs at the next
+// trigger of timing, and returns without waiting. src and dst must stay
+// alive until IsBusy reports false.
+func (c *Channel) Start32(src, dst unsafe.Pointer, count int, timing DMATiming) error {
+	if count <= 0 || count > c.maxCount {
+		return ErrInvalidCount
+	}
+	if err := c.claim(); err != nil {
+		return err
+	}
+	c.start(uintptr(src), uintptr(dst), count, srcIncrement|destIncrement|transfer32, timing)
+	return nil
+}
+
+// Stop cancels a pending or repeating transfer. The completion callback of
+// a cancelled async transfer does not run.
+func (c *Channel) Stop() {
+	c.halt()
+	c.done = nil
+}
+
+func (c *Channel) halt() {
+	c.cntH.ClearBits(enableBit)
+}
+
+// claim stops the channel so it can be reprogrammed. It fails with ErrBusy
+// while an async transfer with a completion callback is pending, and runs
+// the callback of one that finished before its interrupt was served.
+func (c *Channel) claim() error {
+	if c.done != nil {
+		if c.IsBusy() {
+			return ErrBusy
+		}
+		registers.Interrupt.IF.Set(1 << c.irq) // acknowledge the stale request
+		c.handleDone()
+	}
+	c.halt()
+	return nil
+}
+
+// IsBusy reports whether the channel has a transfer armed or running.
+func (c *Channel) IsBusy() bool {
+	return c.cntH.HasBits(enableBit)
+}
+
+// setFill stores the fill source with a volatile write, so the value is in
+// memory before the DMA reads it rather than held in a register.
+func (c *Channel) setFill(value uint32) {
+	volatile.StoreUint32(&c.fill, value)
+}
+
+func (c *Channel) transfer(src, dst uintptr, count int, control uint16) error {
+	if count <= 0 || count > c.maxCount {
+		return ErrInvalidCount
+	}
+	if err := c.claim(); err != nil {
+		return err
+	}
+
+	c.start(src, dst, count, control, TimingImmediate)
+	for c.IsBusy() {
+	}
+	return nil
+}
+
+// start programs and enables the channel, which must have been claimed.
+func (c *Channel) start(src, dst uintptr, count int, control uint16, timing DMATiming) {
+	c.sad.Set(uint32(src))
+	c.dad.Set(uint32(dst))
+	c.cntL.Set(uint16(count)) // a full-size count wraps to 0, which the hardware reads as the maximum
+	c.cntH.Set(control | uint16(timing)<<timingShift | enableBit)
+}
@@ -0,0 +1,51 @@
+package dma
+
+import (
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/interrupts"
+)
+
+const fifoBurst = 4 // words moved per FIFO request
+
+// StartSoundFIFO streams words from src into a DirectSound FIFO (FIFO_A or
+// FIFO_B) whenever the FIFO asks for more data. Only channels 1 and 2 can
+// feed the FIFOs. src must be word-aligned and stay alive until the
+// transfer ends.
+//
+// If words is positive the channel stops by itself once that many words
+// (rounded up to the 4-word burst) have been sent and then calls done, if
+// not nil, from the DMA interrupt. Otherwise it streams until Stop.
+func (c *Channel) StartSoundFIFO(src, fifo unsafe.Pointer, words int, done func()) error {
+	if c != Channel1 && c != Channel2 {
+		return ErrInvalidChannel
+	}
+
+	if err := c.claim(); err != nil {
+		return err
+	}
+	control := srcIncrement | destFixed | repeatBit | transfer32 | uint16(TimingSpecial)<<timingShift | enableBit
+	if words > 0 {
+		c.remaining = words
+		c.done = done
+		if err := interrupts.EnableInterrupt(c.irq, c.handleFIFOBurst); err != nil {
+			return err
+		}
+		control |= irqBit
+	}
+	c.sad.Set(uint32(uintptr(src)))
+	c.dad.Set(uint32(uintptr(fifo)))
+	c.cntL.Set(fifoBurst)
+	c.cntH.Set(control)
+	return nil
+}
+
+// handleFIFOBurst runs after every burst of a counted FIFO transfer.
+func (c *Channel) handleFIFOBurst() {
+	c.remaining -= fifoBurst
+	if c.remaining > 0 {
+		return
+	}
+	c.halt()
+	c.handleDone()
+}
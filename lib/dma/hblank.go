@@ -0,0 +1,93 @@
+package dma
+
+import (
+	"errors"
+	"runtime/volatile"
+	"unsafe"
+)
+
+const (
+	destReload = 3 << 5
+	repeatBit  = 1 << 9
+)
+
+// HBlankLines is the number of entries an HBlank table needs: one per
+// visible scanline, plus one more that the DMA fetches at the HBlank after
+// line 159, before VBlank stops it.
+const HBlankLines = 161
+
+var (
+	ErrInvalidChannel = errors.New("dma: channel must be 0-3")
+	ErrShortSource    = errors.New("dma: HBlank source needs HBlankLines entries")
+)
+
+var channels = [...]*Channel{Channel0, Channel1, Channel2, Channel3}
+
+// StartHBlank arms a repeating DMA that writes the next value of src into
+// dst at every HBlank, giving one value per scanline. src[0] is written
+// immediately since the first HBlank happens after line 0 is drawn.
+// src must hold at least HBlankLines values; the last one is read but
+// never shown.
+//
+// The source address only advances, so StartHBlank has to be called again
+// during every VBlank to restart from the top of src. The DMA reads src
+// directly, so it must stay alive and unmoved while the effect runs;
+// keep it in a package-level variable. Call StopHBlank to end the effect.
+func StartHBlank(channel int, src []uint16, dst *volatile.Register16) error {
+	if channel < 0 || channel >= len(channels) {
+		return ErrInvalidChannel
+	}
+	if len(src) < HBlankLines {
+		return ErrShortSource
+	}
+
+	c := channels[channel]
+	if err := c.claim(); err != nil {
+		return err
+	}
+	dst.Set(src[0])
+
+	c.sad.Set(uint32(uintptr(unsafe.Pointer(&src[1]))))
+	c.dad.Set(uint32(uintptr(unsafe.Pointer(dst))))
+	c.cntL.Set(1)
+	c.cntH.Set(srcIncrement | destReload | repeatBit | uint16(TimingHBlank)<<timingShift | enableBit)
+	return nil
+}
+
+// StartHBlankBlock is like StartHBlank but copies a block of words 32-bit
+// words to dst on every HBlank, e.g. a full set of BG2 affine registers per
+// scanline. src points at one block per scanline; the first block is
+// copied immediately. Like StartHBlank it reads HBlankLines blocks, so src
+// must be that long.
+func StartHBlankBlock(channel int, src unsafe.Pointer, words int, dst unsafe.Pointer) error {
+	if channel < 0 || channel >= len(channels) {
+		return ErrInvalidChannel
+	}
+	c := channels[channel]
+	if words <= 0 || words > c.maxCount {
+		return ErrInvalidCount
+	}
+
+	if err := c.claim(); err != nil {
+		return err
+	}
+	for i := 0; i < words; i++ {
+		v := volatile.LoadUint32((*uint32)(unsafe.Add(src, i*4)))
+		volatile.StoreUint32((*uint32)(unsafe.Add(dst, i*4)), v)
+	}
+
+	c.sad.Set(uint32(uintptr(src)) + uint32(words*4))
+	c.dad.Set(uint32(uintptr(dst)))
+	c.cntL.Set(uint16(words))
+	c.cntH.Set(srcIncrement | destReload | repeatBit | transfer32 | uint16(TimingHBlank)<<timingShift | enableBit)
+	return nil
+}
+
+// StopHBlank stops an HBlank DMA started with StartHBlank or
+// StartHBlankBlock.
+func StopHBlank(channel int) {
+	if channel < 0 || channel >= len(channels) {
+		return
+	}
+	channels[channel].Stop()
+}
@@ -1,8 +1,26 @@
 package drawing
 
 import (
+	"errors"
+	"unsafe"
+
 	"github.com/matheusmortatti/gba-go/lib/bios"
+	"github.com/matheusmortatti/gba-go/lib/dma"
 	"github.com/matheusmortatti/gba-go/lib/registers"
+	"github.com/matheusmortatti/gba-go/lib/video"
+)
+
+const (
+	vramBase  = 0x06000000
+	pageSize  = 0xA000 // offset of the second frame in modes 4 and 5
+	mode3Size = 240 * 160 * 2
+	mode4Size = 240 * 160
+	mode5Size = 160 * 128 * 2
+)
+
+var (
+	ErrInvalidMode = errors.New("drawing: mode has no bitmap frame")
+	ErrInvalidPage = errors.New("drawing: page out of range for mode")
 )
 
 func VCount() uint16 {
@@ -13,11 +31,41 @@ func VSync() {
 	bios.VBlankIntrWait()
 }
 
-var drawPage = 1
-
+// Display shows the page that was drawn into. Page flipping is owned by
+// the video package; use video.BackPage to find the page to draw into.
 func Display() error {
-	old := registers.Lcd.DISPCNT.Get()
-	registers.Lcd.DISPCNT.Set(old ^ (uint16(drawPage) << 4)) // flip display
-	drawPage ^= 1                                            // switch drawPage
+	video.Flip()
 	return nil
 }
+
+// ClearActiveFrame zeroes only the frame buffer used by the given bitmap
+// mode (3, 4 or 5) and page, instead of the whole 96KB of VRAM.
+func ClearActiveFrame(mode, page int) error {
+	addr, size, err := frame(mode, page)
+	if err != nil {
+		return err
+	}
+	return dma.Channel3.Fill32(unsafe.Pointer(addr), 0, size/4)
+}
+
+// frame returns the VRAM address and size in bytes of a bitmap frame.
+func frame(mode, page int) (uintptr, int, error) {
+	var size int
+	switch mode {
+	case 3:
+		if page != 0 {
+			return 0, 0, ErrInvalidPage
+		}
+		size = mode3Size
+	case 4:
+		size = mode4Size
+	case 5:
+		size = mode5Size
+	default:
+		return 0, 0, ErrInvalidMode
+	}
+	if page < 0 || page > 1 {
+		return 0, 0, ErrInvalidPage
+	}
+	return uintptr(vramBase + page*pageSize), size, nil
+}
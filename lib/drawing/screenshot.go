@@ -0,0 +1,69 @@
+package drawing
+
+import (
+	"runtime/volatile"
+	"strconv"
+	"unsafe"
+)
+
+const bgPaletteBase = 0x05000000
+
+// Dump returns a copy of the raw frame buffer for a bitmap mode and page:
+// RGB15 pixels in modes 3 and 5, palette indices in mode 4.
+func Dump(mode, page int) ([]byte, error) {
+	addr, size, err := frame(mode, page)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, size)
+	// VRAM is read a halfword at a time.
+	for i := 0; i < size; i += 2 {
+		v := volatile.LoadUint16((*uint16)(unsafe.Pointer(addr + uintptr(i))))
+		data[i] = byte(v)
+		data[i+1] = byte(v >> 8)
+	}
+	return data, nil
+}
+
+// DumpPPM returns the frame for a bitmap mode and page as a binary PPM
+// image, which most image viewers can open. In mode 4 the indices are
+// resolved through the background palette. VRAM is read while encoding, so
+// the image is the only allocation.
+func DumpPPM(mode, page int) ([]byte, error) {
+	addr, _, err := frame(mode, page)
+	if err != nil {
+		return nil, err
+	}
+	width, height := 240, 160
+	if mode == 5 {
+		width, height = 160, 128
+	}
+
+	out := make([]byte, 0, 16+width*height*3)
+	out = append(out, "P6\n"...)
+	out = strconv.AppendInt(out, int64(width), 10)
+	out = append(out, ' ')
+	out = strconv.AppendInt(out, int64(height), 10)
+	out = append(out, "\n255\n"...)
+
+	for i := 0; i < width*height; i++ {
+		var color uint16
+		if mode == 4 {
+			// Two indices share each halfword, the first in the low byte.
+			pair := volatile.LoadUint16((*uint16)(unsafe.Pointer(addr + uintptr(i&^1))))
+			index := pair >> (8 * (i & 1)) & 0xFF
+			entry := uintptr(bgPaletteBase + int(index)*2)
+			color = volatile.LoadUint16((*uint16)(unsafe.Pointer(entry)))
+		} else {
+			color = volatile.LoadUint16((*uint16)(unsafe.Pointer(addr + uintptr(i*2))))
+		}
+		out = append(out, expand5(color), expand5(color>>5), expand5(color>>10))
+	}
+	return out, nil
+}
+
+// expand5 scales the low 5 bits of c to the full 0-255 range.
+func expand5(c uint16) byte {
+	v := byte(c & 0x1F)
+	return v<<3 | v>>2
+}
@@ -0,0 +1,64 @@
+package effects
+
+import (
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// LayerMask selects layers as blend targets. The bit layout matches the
+// target fields of BLDCNT.
+type LayerMask uint16
+
+const (
+	LayerBG0 LayerMask = 1 << iota
+	LayerBG1
+	LayerBG2
+	LayerBG3
+	LayerOBJ
+	LayerBackdrop
+
+	LayerAll = LayerBG0 | LayerBG1 | LayerBG2 | LayerBG3 | LayerOBJ | LayerBackdrop
+)
+
+// BrightnessMode selects between fading towards white or towards black.
+type BrightnessMode uint16
+
+const (
+	BrightnessIncrease BrightnessMode = 2
+	BrightnessDecrease BrightnessMode = 3
+)
+
+const (
+	blendNone  = 0
+	blendAlpha = 1
+
+	maxCoefficient = 16
+)
+
+// SetAlphaBlend blends the top layers over the bottom layers using
+// top*evA/16 + bottom*evB/16. Coefficients are clamped to 0-16.
+func SetAlphaBlend(top, bottom LayerMask, evA, evB int) {
+	registers.Lcd.BLDCNT.Set(uint16(top&LayerAll) | blendAlpha<<6 | uint16(bottom&LayerAll)<<8)
+	registers.Lcd.BLDALPHA.Set(uint16(clamp(evA)) | uint16(clamp(evB))<<8)
+}
+
+// SetBrightness fades all layers towards white or black. evy ranges from
+// 0 (unchanged) to 16 (fully white or black) and is clamped to that range.
+func SetBrightness(mode BrightnessMode, evy int) {
+	registers.Lcd.BLDCNT.Set(uint16(LayerAll) | uint16(mode)<<6)
+	registers.Lcd.BLDY.Set(uint16(clamp(evy)))
+}
+
+// DisableBlend turns off any alpha blending or brightness effect.
+func DisableBlend() {
+	registers.Lcd.BLDCNT.Set(blendNone)
+}
+
+func clamp(ev int) int {
+	if ev < 0 {
+		return 0
+	}
+	if ev > maxCoefficient {
+		return maxCoefficient
+	}
+	return ev
+}
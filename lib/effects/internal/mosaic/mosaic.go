@@ -0,0 +1,32 @@
+// Package mosaic packs MOSAIC register fields. It has no hardware
+// dependencies so it can be tested on the host.
+package mosaic
+
+const (
+	MaxSize = 15
+
+	BGShift  = 0
+	OBJShift = 8
+)
+
+// Pack returns the horizontal and vertical block sizes as one byte of
+// MOSAIC, clamping each to 0-15.
+func Pack(hSize, vSize int) uint16 {
+	return uint16(clamp(hSize)) | uint16(clamp(vSize))<<4
+}
+
+// Set returns reg with the byte at shift (BGShift or OBJShift) replaced by
+// the packed sizes, leaving the other byte untouched.
+func Set(reg uint16, shift uint, hSize, vSize int) uint16 {
+	return reg&^(0xFF<<shift) | Pack(hSize, vSize)<<shift
+}
+
+func clamp(v int) int {
+	if v < 0 {
+		return 0
+	}
+	if v > MaxSize {
+		return MaxSize
+	}
+	return v
+}
@@ -0,0 +1,33 @@
+package mosaic
+
+import "testing"
+
+func TestPack(t *testing.T) {
+	tests := []struct {
+		h, v int
+		want uint16
+	}{
+		{0, 0, 0x00},
+		{1, 2, 0x21},
+		{15, 15, 0xFF},
+		{16, 3, 0x3F},
+		{-1, 20, 0xF0},
+	}
+	for _, tt := range tests {
+		if got := Pack(tt.h, tt.v); got != tt.want {
+			t.Errorf("Pack(%d, %d) = %#x, want %#x", tt.h, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestSetKeepsOtherHalf(t *testing.T) {
+	reg := Set(0, BGShift, 3, 4)
+	reg = Set(reg, OBJShift, 5, 6)
+	if reg != 0x6543 {
+		t.Fatalf("after BG then OBJ: %#x, want 0x6543", reg)
+	}
+	reg = Set(reg, BGShift, 1, 1)
+	if reg != 0x6511 {
+		t.Fatalf("after BG update: %#x, want 0x6511", reg)
+	}
+}
@@ -0,0 +1,47 @@
+package effects
+
+import (
+	"runtime/volatile"
+
+	"github.com/matheusmortatti/gba-go/lib/effects/internal/mosaic"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const bgMosaicBit = 1 << 6
+
+// MOSAIC is write-only, so reads return garbage; both halves are kept
+// here and written together.
+var mosaicShadow uint16
+
+var bgControl = [4]*volatile.Register16{
+	registers.Lcd.BG0CNT,
+	registers.Lcd.BG1CNT,
+	registers.Lcd.BG2CNT,
+	registers.Lcd.BG3CNT,
+}
+
+// SetBGMosaic sets the mosaic block size for backgrounds. Sizes range from
+// 0 to 15, meaning blocks of 1 to 16 pixels, and are clamped to that range.
+func SetBGMosaic(hSize, vSize int) {
+	mosaicShadow = mosaic.Set(mosaicShadow, mosaic.BGShift, hSize, vSize)
+	registers.Lcd.MOSAIC.Set(mosaicShadow)
+}
+
+// SetOBJMosaic sets the mosaic block size for sprites, using the same
+// 0-15 range as SetBGMosaic.
+func SetOBJMosaic(hSize, vSize int) {
+	mosaicShadow = mosaic.Set(mosaicShadow, mosaic.OBJShift, hSize, vSize)
+	registers.Lcd.MOSAIC.Set(mosaicShadow)
+}
+
+// EnableBGMosaic turns the mosaic effect on or off for background bg (0-3).
+func EnableBGMosaic(bg int, on bool) {
+	if bg < 0 || bg >= len(bgControl) {
+		return
+	}
+	if on {
+		bgControl[bg].SetBits(bgMosaicBit)
+	} else {
+		bgControl[bg].ClearBits(bgMosaicBit)
+	}
+}
@@ -0,0 +1,32 @@
+package effects
+
+import "github.com/matheusmortatti/gba-go/lib/registers"
+
+const (
+	objWindowShift = 8
+	objWindowBit   = 1 << 15 // DISPCNT
+)
+
+// OBJWindow is the window formed by the opaque pixels of sprites in OBJ
+// window mode, e.g. a flashlight shaped by a sprite. Those sprites are not
+// drawn themselves; they only mark the window region (see
+// oam.OBJWindowAttr0). Windows 0 and 1 take priority over it.
+var OBJWindow = &objWindow{}
+
+type objWindow struct{}
+
+// SetLayers selects the layers visible inside the OBJ window. Include
+// WindowBlend to apply color special effects inside it.
+func (w *objWindow) SetLayers(inside LayerMask) {
+	registers.Lcd.WINOUT.ReplaceBits(uint16(inside&windowLayers), windowLayers, objWindowShift)
+}
+
+// Enable turns the OBJ window on in DISPCNT.
+func (w *objWindow) Enable() {
+	registers.Lcd.DISPCNT.SetBits(objWindowBit)
+}
+
+// Disable turns the OBJ window off in DISPCNT.
+func (w *objWindow) Disable() {
+	registers.Lcd.DISPCNT.ClearBits(objWindowBit)
+}
@@ -0,0 +1,52 @@
+package effects
+
+import (
+	"errors"
+
+	"github.com/matheusmortatti/gba-go/lib/dma"
+	"github.com/matheusmortatti/gba-go/lib/fixed"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+var ErrInvalidBG = errors.New("effects: background must be 0-3")
+
+// HorizontalWave shifts every scanline of a text background sideways by a
+// sine offset through HBlank DMA, for water or heat shimmer.
+//
+// The DMA reads the offset table from the HorizontalWave value, so keep it
+// in a package-level variable.
+type HorizontalWave struct {
+	BG         int // background 0-3
+	Channel    int // DMA channel used for the HBlank transfer, usually 0
+	Amplitude  int // largest offset in pixels
+	Wavelength int // scanlines per wave cycle
+	ScrollX    int // horizontal scroll the wave is added to
+
+	offsets [dma.HBlankLines]uint16
+}
+
+// Update computes the offsets for phase (0x10000 is a full cycle) and
+// restarts the HBlank DMA. Call it once per frame during VBlank, advancing
+// phase to animate the wave.
+func (w *HorizontalWave) Update(phase uint16) error {
+	if w.BG < 0 || w.BG >= len(registers.BGOffset) {
+		return ErrInvalidBG
+	}
+	step := 0
+	if w.Wavelength > 0 {
+		step = 0x10000 / w.Wavelength
+	}
+	for y := range w.offsets {
+		s := fixed.Sin(phase + uint16(y*step))
+		w.offsets[y] = uint16(w.ScrollX + fixed.FromInt(w.Amplitude).Mul(s).Int())
+	}
+	return dma.StartHBlank(w.Channel, w.offsets[:], registers.BGOffset[w.BG].HOFS)
+}
+
+// Stop ends the effect and restores the plain scroll offset.
+func (w *HorizontalWave) Stop() {
+	dma.StopHBlank(w.Channel)
+	if w.BG >= 0 && w.BG < len(registers.BGOffset) {
+		registers.BGOffset[w.BG].HOFS.Set(uint16(w.ScrollX))
+	}
+}
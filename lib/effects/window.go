@@ -0,0 +1,81 @@
+package effects
+
+import (
+	"runtime/volatile"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// WindowBlend enables color special effects (see SetAlphaBlend and
+// SetBrightness) for pixels in a window region. It shares the bit used by
+// LayerBackdrop in BLDCNT.
+const WindowBlend LayerMask = 1 << 5
+
+const (
+	screenWidth  = 240
+	screenHeight = 160
+
+	windowLayers = 0x3F
+)
+
+type Window struct {
+	h, v     *volatile.Register16
+	shift    uint8  // position of this window's fields in WININ
+	dispBits uint16 // enable bit in DISPCNT
+}
+
+var (
+	Window0 = &Window{
+		h:        registers.Lcd.WIN0H,
+		v:        registers.Lcd.WIN0V,
+		shift:    0,
+		dispBits: 1 << 13,
+	}
+	Window1 = &Window{
+		h:        registers.Lcd.WIN1H,
+		v:        registers.Lcd.WIN1V,
+		shift:    8,
+		dispBits: 1 << 14,
+	}
+)
+
+// SetBounds sets the window rectangle. left/top are inclusive and
+// right/bottom exclusive, so right == 240 reaches the right edge of the
+// screen. Coordinates are clamped to the screen. If left > right (or
+// top > bottom) the hardware extends the window to the screen edge instead
+// of wrapping around.
+func (w *Window) SetBounds(left, top, right, bottom int) {
+	w.h.Set(uint16(clampTo(left, screenWidth))<<8 | uint16(clampTo(right, screenWidth)))
+	w.v.Set(uint16(clampTo(top, screenHeight))<<8 | uint16(clampTo(bottom, screenHeight)))
+}
+
+// SetLayers selects the layers visible inside the window. Include
+// WindowBlend to apply color special effects inside it.
+func (w *Window) SetLayers(inside LayerMask) {
+	registers.Lcd.WININ.ReplaceBits(uint16(inside&windowLayers), windowLayers, w.shift)
+}
+
+// Enable turns the window on in DISPCNT.
+func (w *Window) Enable() {
+	registers.Lcd.DISPCNT.SetBits(w.dispBits)
+}
+
+// Disable turns the window off in DISPCNT.
+func (w *Window) Disable() {
+	registers.Lcd.DISPCNT.ClearBits(w.dispBits)
+}
+
+// SetOutside selects the layers visible outside of every enabled window.
+func SetOutside(outside LayerMask) {
+	registers.Lcd.WINOUT.ReplaceBits(uint16(outside&windowLayers), windowLayers, 0)
+}
+
+func clampTo(v, max int) int {
+	if v < 0 {
+		return 0
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
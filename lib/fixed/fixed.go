@@ -0,0 +1,30 @@
+package fixed
+
+// Fixed is a signed 24.8 fixed-point number, the format used by the
+// affine background registers.
+type Fixed int32
+
+const (
+	Shift       = 8
+	One   Fixed = 1 << Shift
+)
+
+// FromInt converts an integer to Fixed.
+func FromInt(i int) Fixed {
+	return Fixed(i << Shift)
+}
+
+// Int returns the integer part of f, rounding towards negative infinity.
+func (f Fixed) Int() int {
+	return int(f >> Shift)
+}
+
+// Mul returns f*g.
+func (f Fixed) Mul(g Fixed) Fixed {
+	return Fixed(int64(f) * int64(g) >> Shift)
+}
+
+// Div returns f/g. g must not be zero.
+func (f Fixed) Div(g Fixed) Fixed {
+	return Fixed(int64(f) << Shift / int64(g))
+}
@@ -0,0 +1,39 @@
+package fixed
+
+// Angles are uint16 where 0x10000 is a full turn, so they wrap around
+// naturally. Sin and Cos use 256 steps per turn; the low 8 bits of the
+// angle are ignored.
+
+// quarterSine holds sin over a quarter turn in 64 steps.
+var quarterSine = [65]Fixed{
+	0, 6, 13, 19, 25, 31, 38, 44,
+	50, 56, 62, 68, 74, 80, 86, 92,
+	98, 104, 109, 115, 121, 126, 132, 137,
+	142, 147, 152, 157, 162, 167, 172, 177,
+	181, 185, 190, 194, 198, 202, 206, 209,
+	213, 216, 220, 223, 226, 229, 231, 234,
+	237, 239, 241, 243, 245, 247, 248, 250,
+	251, 252, 253, 254, 255, 255, 256, 256,
+	256,
+}
+
+// Sin returns the sine of angle.
+func Sin(angle uint16) Fixed {
+	step := angle >> 8 // 0-255
+	i := step & 63
+	switch step >> 6 {
+	case 0:
+		return quarterSine[i]
+	case 1:
+		return quarterSine[64-i]
+	case 2:
+		return -quarterSine[i]
+	default:
+		return -quarterSine[64-i]
+	}
+}
+
+// Cos returns the cosine of angle.
+func Cos(angle uint16) Fixed {
+	return Sin(angle + 0x4000)
+}
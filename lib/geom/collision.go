@@ -0,0 +1,17 @@
+package geom
+
+// Rectangles are half-open, so two rectangles that only share an edge,
+// such as {0, 0, 8, 8} and {8, 0, 8, 8}, do not overlap.
+
+// RectsOverlap reports whether a and b share at least one pixel.
+func RectsOverlap(a, b Rect) bool {
+	if a.IsEmpty() || b.IsEmpty() {
+		return false
+	}
+	return a.X < b.Right() && b.X < a.Right() && a.Y < b.Bottom() && b.Y < a.Bottom()
+}
+
+// PointInRect reports whether the point x, y lies inside r.
+func PointInRect(x, y int, r Rect) bool {
+	return r.Contains(x, y)
+}
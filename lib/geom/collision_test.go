@@ -0,0 +1,55 @@
+package geom
+
+import "testing"
+
+func TestRectsOverlap(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Rect
+		want bool
+	}{
+		{"same", Rect{0, 0, 8, 8}, Rect{0, 0, 8, 8}, true},
+		{"partial", Rect{0, 0, 8, 8}, Rect{4, 4, 8, 8}, true},
+		{"inside", Rect{0, 0, 8, 8}, Rect{2, 2, 2, 2}, true},
+		{"one pixel", Rect{0, 0, 8, 8}, Rect{7, 7, 8, 8}, true},
+		{"touch right edge", Rect{0, 0, 8, 8}, Rect{8, 0, 8, 8}, false},
+		{"touch left edge", Rect{8, 0, 8, 8}, Rect{0, 0, 8, 8}, false},
+		{"touch bottom edge", Rect{0, 0, 8, 8}, Rect{0, 8, 8, 8}, false},
+		{"touch corner", Rect{0, 0, 8, 8}, Rect{8, 8, 8, 8}, false},
+		{"apart", Rect{0, 0, 8, 8}, Rect{20, 20, 8, 8}, false},
+		{"zero width", Rect{2, 2, 0, 4}, Rect{0, 0, 8, 8}, false},
+		{"zero height", Rect{0, 0, 8, 8}, Rect{2, 2, 4, 0}, false},
+		{"both empty", Rect{2, 2, 0, 0}, Rect{2, 2, 0, 0}, false},
+		{"negative coords", Rect{-8, -8, 8, 8}, Rect{-4, -4, 8, 8}, true},
+		{"negative touch", Rect{-8, -8, 8, 8}, Rect{0, -8, 8, 8}, false},
+		{"negative size", Rect{0, 0, -4, 8}, Rect{-2, 0, 8, 8}, false},
+	}
+	for _, tt := range tests {
+		if got := RectsOverlap(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: RectsOverlap(%v, %v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestPointInRect(t *testing.T) {
+	r := Rect{-4, -4, 8, 8}
+	tests := []struct {
+		x, y int
+		want bool
+	}{
+		{-4, -4, true},
+		{3, 3, true},
+		{4, 0, false},
+		{0, 4, false},
+		{-5, 0, false},
+		{0, 0, true},
+	}
+	for _, tt := range tests {
+		if got := PointInRect(tt.x, tt.y, r); got != tt.want {
+			t.Errorf("PointInRect(%d, %d, %v) = %v, want %v", tt.x, tt.y, r, got, tt.want)
+		}
+	}
+	if PointInRect(0, 0, Rect{0, 0, 0, 0}) {
+		t.Errorf("zero-size rect contains its origin")
+	}
+}
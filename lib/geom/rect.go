@@ -0,0 +1,46 @@
+package geom
+
+// Rect is an axis-aligned rectangle covering X <= x < X+Width and
+// Y <= y < Y+Height.
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Right returns the first column to the right of the rectangle.
+func (r Rect) Right() int {
+	return r.X + r.Width
+}
+
+// Bottom returns the first row below the rectangle.
+func (r Rect) Bottom() int {
+	return r.Y + r.Height
+}
+
+// IsEmpty reports whether the rectangle covers no pixels.
+func (r Rect) IsEmpty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// Contains reports whether the point x, y lies inside the rectangle.
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.Right() && y >= r.Y && y < r.Bottom()
+}
+
+// Intersect returns the area covered by both rectangles, or an empty Rect
+// if they do not overlap.
+func (r Rect) Intersect(other Rect) Rect {
+	left := max(r.X, other.X)
+	top := max(r.Y, other.Y)
+	right := min(r.Right(), other.Right())
+	bottom := min(r.Bottom(), other.Bottom())
+	if right <= left || bottom <= top {
+		return Rect{}
+	}
+	return Rect{X: left, Y: top, Width: right - left, Height: bottom - top}
+}
+
+// Clamp returns the part of the rectangle that lies within bounds.
+func (r Rect) Clamp(bounds Rect) Rect {
+	return r.Intersect(bounds)
+}
@@ -0,0 +1,36 @@
+package geom
+
+import "testing"
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Rect
+		want Rect
+	}{
+		{"overlap", Rect{0, 0, 8, 8}, Rect{4, 2, 8, 8}, Rect{4, 2, 4, 6}},
+		{"inside", Rect{0, 0, 8, 8}, Rect{2, 2, 2, 2}, Rect{2, 2, 2, 2}},
+		{"touch edge", Rect{0, 0, 8, 8}, Rect{8, 0, 8, 8}, Rect{}},
+		{"touch corner", Rect{0, 0, 8, 8}, Rect{8, 8, 8, 8}, Rect{}},
+		{"zero size", Rect{0, 0, 8, 8}, Rect{4, 4, 0, 0}, Rect{}},
+		{"negative coords", Rect{-8, -8, 10, 10}, Rect{-4, -6, 10, 10}, Rect{-4, -6, 6, 8}},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Intersect(tt.b); got != tt.want {
+			t.Errorf("%s: %v.Intersect(%v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+		if got := tt.b.Intersect(tt.a); got != tt.want {
+			t.Errorf("%s: %v.Intersect(%v) = %v, want %v", tt.name, tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestContainsEdges(t *testing.T) {
+	r := Rect{0, 0, 8, 8}
+	if !r.Contains(0, 0) || !r.Contains(7, 7) {
+		t.Errorf("%v should contain its first and last pixel", r)
+	}
+	if r.Contains(8, 0) || r.Contains(0, 8) || r.Contains(-1, 0) {
+		t.Errorf("%v contains a point outside its half-open bounds", r)
+	}
+}
@@ -0,0 +1,52 @@
+package input
+
+// 8-way directions returned by DirectionVec8, clockwise from up.
+const (
+	DirNone = iota
+	DirUp
+	DirUpRight
+	DirRight
+	DirDownRight
+	DirDown
+	DirDownLeft
+	DirLeft
+	DirUpLeft
+)
+
+// dirTable maps dy+1, dx+1 to an 8-way direction.
+var dirTable = [3][3]int{
+	{DirUpLeft, DirUp, DirUpRight},
+	{DirLeft, DirNone, DirRight},
+	{DirDownLeft, DirDown, DirDownRight},
+}
+
+// Direction returns the D-pad state as -1, 0 or 1 per axis, with y
+// growing downwards like screen coordinates. Opposite keys cancel out.
+func Direction() (dx, dy int) {
+	if BtnDown(KeyLeft) {
+		dx--
+	}
+	if BtnDown(KeyRight) {
+		dx++
+	}
+	if BtnDown(KeyUp) {
+		dy--
+	}
+	if BtnDown(KeyDown) {
+		dy++
+	}
+	return dx, dy
+}
+
+// DirectionVec8 returns the D-pad state as one of the Dir constants.
+func DirectionVec8() int {
+	dx, dy := Direction()
+	return dirTable[dy+1][dx+1]
+}
+
+// AnyDirection returns true if any D-pad key is down.
+func AnyDirection() bool {
+	const dpad = KeyUp | KeyDown | KeyLeft | KeyRight
+	// KEYINPUT is active-low, so a cleared bit means the key is down.
+	return currentState&dpad != dpad
+}
@@ -1,6 +1,10 @@
 package input
 
 import (
+	"errors"
+	"math/bits"
+
+	"github.com/matheusmortatti/gba-go/lib/bios"
 	"github.com/matheusmortatti/gba-go/lib/interrupts"
 	"github.com/matheusmortatti/gba-go/lib/registers"
 )
@@ -18,14 +22,37 @@ const (
 	KeyL
 )
 
+// KeyIRQMode selects how the keys passed to EnableKeyInterrupt combine.
+type KeyIRQMode uint16
+
+const (
+	KeyIRQAny KeyIRQMode = 0       // logical OR: any of the keys
+	KeyIRQAll KeyIRQMode = 1 << 15 // logical AND: all keys at once
+)
+
+const (
+	allKeys      = 0x3FF
+	keyIRQEnable = 1 << 14
+)
+
+var ErrInvalidKeys = errors.New("input: keys must be a non-empty 10-bit key mask")
+
 var (
 	lastState    uint16 = 0x3FF
 	currentState uint16 = 0x3FF
+
+	// heldFrames counts, per key, the polls the key has been down for.
+	heldFrames [10]int
+
+	// State of the shared keypad interrupt handler.
+	pollingEnabled   bool
+	softResetCombo   uint16
+	softResetHandler func()
 )
 
 // WasBtnDown returns true if the key was down in the last frame.
 func WasBtnDown(key uint16) bool {
-	return lastState&key != 0
+	return lastState&key == 0
 }
 
 // BtnDown returns true if the key is currently down.
@@ -43,19 +70,124 @@ func BtnClicked(key uint16) bool {
 	return BtnDown(key) && !WasBtnDown(key)
 }
 
+// BtnReleased returns true if the key was released in the current frame.
+func BtnReleased(key uint16) bool {
+	return BtnUp(key) && WasBtnDown(key)
+}
+
+// ChangedKeys returns a mask of the keys that were pressed or released in
+// the current frame.
+func ChangedKeys() uint16 {
+	return (lastState ^ currentState) & allKeys
+}
+
+// BtnRepeat returns true on the frame key is pressed and then, while it
+// is held, every interval frames once it has been down for delay frames.
+// It counts calls to Poll, so Poll must run exactly once per frame.
+func BtnRepeat(key uint16, delay, interval int) bool {
+	i := bits.TrailingZeros16(key)
+	if i >= len(heldFrames) {
+		return false
+	}
+	if interval < 1 {
+		interval = 1
+	}
+	n := heldFrames[i] - 1 // frames since the press
+	return n == 0 || n >= delay && (n-delay)%interval == 0
+}
+
+// InputFrame is the key state of one frame, in KEYINPUT's active-low
+// layout. A recorded sequence of frames can be replayed with ApplyInput.
+type InputFrame struct {
+	State uint16
+}
+
+// CaptureInput returns the key state of the current frame.
+func CaptureInput() InputFrame {
+	return InputFrame{State: currentState}
+}
+
+// ApplyInput advances one frame using f instead of the hardware state, so
+// BtnDown, BtnClicked and the other queries behave as if f was polled. Call
+// it in place of Poll.
+func ApplyInput(f InputFrame) {
+	update(f.State)
+}
+
 // Poll updates the current and last key states.
 func Poll() {
+	update(registers.Keypad.KEYINPUT.Get())
+}
+
+func update(state uint16) {
 	lastState = currentState
-	currentState = registers.Keypad.KEYINPUT.Get()
+	currentState = state
+	for i := range heldFrames {
+		if currentState&(1<<i) == 0 {
+			heldFrames[i]++
+		} else {
+			heldFrames[i] = 0
+		}
+	}
 }
 
 // EnablePolling enables the keypad polling interrupt.
 func EnablePolling() {
-	registers.Keypad.KEYCNT.SetBits(1 << 0xE)
-	registers.Keypad.KEYCNT.SetBits(0b1111111111)
+	pollingEnabled = true
+	registers.Keypad.KEYCNT.Set(allKeys | keyIRQEnable | uint16(KeyIRQAny))
 	interrupts.EnableKeypadPollingInterrupt(keyInterruptHandler)
 }
 
+// keyInterruptHandler serves both EnablePolling and EnableSoftReset, which
+// share the keypad interrupt.
 func keyInterruptHandler() {
-	Poll()
+	if pollingEnabled {
+		Poll()
+	}
+	if softResetCombo != 0 && registers.Keypad.KEYINPUT.Get()&softResetCombo == 0 {
+		softResetHandler()
+	}
+}
+
+// EnableKeyInterrupt calls handler when keys are pressed, either any of
+// them or all of them at once depending on mode. The keypad interrupt also
+// wakes the CPU from a halt. It replaces the handlers installed by
+// EnablePolling and EnableSoftReset, since they all use the single keypad
+// interrupt.
+func EnableKeyInterrupt(keys uint16, mode KeyIRQMode, handler func()) error {
+	if keys == 0 || keys&^allKeys != 0 {
+		return ErrInvalidKeys
+	}
+	pollingEnabled = false
+	softResetCombo = 0
+	registers.Keypad.KEYCNT.Set(keys | keyIRQEnable | uint16(mode))
+	return interrupts.EnableInterrupt(interrupts.Keypad, handler)
+}
+
+// SoftResetCombo is the usual A+B+Select+Start reset combination.
+const SoftResetCombo = KeyA | KeyB | KeySelect | KeyStart
+
+// EnableSoftReset calls handler when all keys in combo are held at once,
+// or bios.SoftReset if handler is nil. A combo of 0 uses SoftResetCombo.
+// Since it is driven by the keypad interrupt it also fires while the main
+// loop is halted.
+//
+// It works alongside EnablePolling: with polling on, the interrupt fires on
+// any key and the combo is checked after Poll. A later EnableKeyInterrupt
+// replaces both.
+func EnableSoftReset(combo uint16, handler func()) error {
+	if combo == 0 {
+		combo = SoftResetCombo
+	}
+	if combo&^allKeys != 0 {
+		return ErrInvalidKeys
+	}
+	if handler == nil {
+		handler = bios.SoftReset
+	}
+	softResetCombo, softResetHandler = combo, handler
+	if !pollingEnabled {
+		registers.Keypad.KEYCNT.Set(combo | keyIRQEnable | uint16(KeyIRQAll))
+	}
+	return interrupts.EnableInterrupt(interrupts.Keypad, keyInterruptHandler)
 }
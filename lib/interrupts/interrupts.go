@@ -1,23 +1,131 @@
 package interrupts
 
 import (
+	"errors"
 	"machine"
 	"runtime/interrupt"
 
 	"github.com/matheusmortatti/gba-go/lib/registers"
 )
 
-var handlers = make(map[interrupt.Interrupt]func())
+// IRQSource identifies an interrupt source by its bit in IE/IF.
+type IRQSource uint16
 
+const (
+	VBlank IRQSource = iota
+	HBlank
+	VCount
+	Timer0
+	Timer1
+	Timer2
+	Timer3
+	Serial
+	DMA0
+	DMA1
+	DMA2
+	DMA3
+	Keypad
+	GamePak
+)
+
+const (
+	dispstatVBlank = 1 << 3
+	dispstatHBlank = 1 << 4
+	dispstatVCount = 1 << 5
+)
+
+const lastScanline = 227
+
+var (
+	ErrInvalidSource = errors.New("interrupts: unknown interrupt source")
+	ErrInvalidLine   = errors.New("interrupts: scanline must be 0-227")
+)
+
+type irqHandler struct {
+	source  IRQSource
+	handler func()
+}
+
+var handlers = make(map[interrupt.Interrupt]irqHandler)
+
+// EnableInterrupt registers handler for source and enables it in IE and
+// IME. For VBlank, HBlank and VCount the matching DISPSTAT request bit is
+// set too; other sources also need their own IRQ bit set in the timer, DMA,
+// serial or keypad control register.
+func EnableInterrupt(source IRQSource, handler func()) error {
+	var itr interrupt.Interrupt
+	// interrupt.New needs constant arguments, hence one call per source.
+	switch source {
+	case VBlank:
+		registers.Lcd.DISPSTAT.SetBits(dispstatVBlank)
+		itr = interrupt.New(machine.IRQ_VBLANK, handleInterrupt)
+	case HBlank:
+		registers.Lcd.DISPSTAT.SetBits(dispstatHBlank)
+		itr = interrupt.New(machine.IRQ_HBLANK, handleInterrupt)
+	case VCount:
+		registers.Lcd.DISPSTAT.SetBits(dispstatVCount)
+		itr = interrupt.New(machine.IRQ_VCOUNT, handleInterrupt)
+	case Timer0:
+		itr = interrupt.New(machine.IRQ_TIMER0, handleInterrupt)
+	case Timer1:
+		itr = interrupt.New(machine.IRQ_TIMER1, handleInterrupt)
+	case Timer2:
+		itr = interrupt.New(machine.IRQ_TIMER2, handleInterrupt)
+	case Timer3:
+		itr = interrupt.New(machine.IRQ_TIMER3, handleInterrupt)
+	case Serial:
+		itr = interrupt.New(machine.IRQ_COM, handleInterrupt)
+	case DMA0:
+		itr = interrupt.New(machine.IRQ_DMA0, handleInterrupt)
+	case DMA1:
+		itr = interrupt.New(machine.IRQ_DMA1, handleInterrupt)
+	case DMA2:
+		itr = interrupt.New(machine.IRQ_DMA2, handleInterrupt)
+	case DMA3:
+		itr = interrupt.New(machine.IRQ_DMA3, handleInterrupt)
+	case Keypad:
+		itr = interrupt.New(machine.IRQ_KEYPAD, handleInterrupt)
+	case GamePak:
+		itr = interrupt.New(machine.IRQ_GAMEPAK, handleInterrupt)
+	default:
+		return ErrInvalidSource
+	}
+	enableInterrupt(itr, source, handler)
+	registers.Interrupt.IME.Set(1)
+	return nil
+}
+
+// DisableInterrupt masks source in IE. Its handler stays registered.
+func DisableInterrupt(source IRQSource) {
+	registers.Interrupt.IE.ClearBits(1 << source)
+}
+
+// EnableVCountInterrupt calls handler when the display reaches scanline
+// line (0-227; 160 and up are in VBlank). Calling it again moves the
+// target line and replaces the handler.
+func EnableVCountInterrupt(line int, handler func()) error {
+	if line < 0 || line > lastScanline {
+		return ErrInvalidLine
+	}
+	registers.Lcd.DISPSTAT.ReplaceBits(uint16(line), 0xFF, 8)
+	return EnableInterrupt(VCount, handler)
+}
+
+// EnableVBlankInterrupt calls handler at every VBlank. Only the VBlank
+// request bit of DISPSTAT is set, so a VCount line or HBlank request set
+// up before stays in place.
+//
+// It is kept for existing callers; EnableInterrupt(VBlank, handler) does
+// the same, and each IRQ must only be registered in one place.
 func EnableVBlankInterrupt(handler func()) {
-	registers.Lcd.DISPSTAT.Set(1<<3 | 1<<4 | 1<<0xA)
-	itr := interrupt.New(machine.IRQ_VBLANK, handleInterrupt)
-	enableInterrupt(itr, handler)
+	EnableInterrupt(VBlank, handler) // cannot fail for a known source
 }
 
+// EnableKeypadPollingInterrupt calls handler on keypad interrupts. It
+// is the same as EnableInterrupt(Keypad, handler); KEYCNT must be set up
+// by the caller.
 func EnableKeypadPollingInterrupt(handler func()) {
-	itr := interrupt.New(machine.IRQ_KEYPAD, handleInterrupt)
-	enableInterrupt(itr, handler)
+	EnableInterrupt(Keypad, handler) // cannot fail for a known source
 }
 
 func DisableAllInterrupts() {
@@ -25,13 +133,18 @@ func DisableAllInterrupts() {
 }
 
 func handleInterrupt(itr interrupt.Interrupt) {
-	handler, ok := handlers[itr]
-	if ok {
-		handler()
+	h, ok := handlers[itr]
+	if !ok {
+		return
 	}
+	// Acknowledge the request, and flag it for the BIOS so IntrWait and
+	// VBlankIntrWait return.
+	registers.Interrupt.IF.Set(1 << h.source)
+	registers.Interrupt.IFBios.SetBits(1 << h.source)
+	h.handler()
 }
 
-func enableInterrupt(itr interrupt.Interrupt, handler func()) {
-	handlers[itr] = handler
+func enableInterrupt(itr interrupt.Interrupt, source IRQSource, handler func()) {
+	handlers[itr] = irqHandler{source: source, handler: handler}
 	itr.Enable()
 }
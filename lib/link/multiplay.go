@@ -0,0 +1,92 @@
+package link
+
+import (
+	"errors"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// BaudRate is the multi-play transfer speed. All linked GBAs must agree.
+type BaudRate uint16
+
+const (
+	Baud9600 BaudRate = iota
+	Baud38400
+	Baud57600
+	Baud115200
+)
+
+const (
+	siTerminal  = 1 << 2 // low on the master
+	sdTerminal  = 1 << 3 // high when every unit is ready
+	idMask      = 0b11 << 4
+	errorBit    = 1 << 6
+	startBit    = 1 << 7
+	multiPlay   = 0b10 << 12
+	linkTimeout = 0x40000 // polls before giving up on a transfer
+)
+
+var (
+	ErrNotReady = errors.New("link: not all units are ready")
+	ErrTimeout  = errors.New("link: transfer timed out")
+	ErrTransfer = errors.New("link: transfer error")
+)
+
+// Init puts the serial port in multi-play mode at the given speed.
+func Init(baud BaudRate) {
+	registers.SerialCommunication.RCNT.Set(0)
+	registers.SerialCommunication.SIOCNT.Set(uint16(baud)&0b11 | multiPlay)
+}
+
+// IsMaster reports whether this GBA is the parent, which is the one with
+// the small plug of the cable. Only the master starts transfers.
+func IsMaster() bool {
+	return !registers.SerialCommunication.SIOCNT.HasBits(siTerminal)
+}
+
+// PlayerID returns this GBA's slot (0 for the master, 1-3 for the others),
+// as assigned by the last transfer.
+func PlayerID() int {
+	return int(registers.SerialCommunication.SIOCNT.Get()&idMask) >> 4
+}
+
+// Transfer exchanges one halfword with every linked GBA and returns the
+// values sent by players 0 to 3; missing players read as 0xFFFF. On the
+// master it starts the transfer, on the others it waits for the master to
+// start one.
+func Transfer(send uint16) ([4]uint16, error) {
+	sio := registers.SerialCommunication
+	var recv [4]uint16
+
+	sio.SIOMLT_SEND.Set(send)
+	if IsMaster() {
+		if !sio.SIOCNT.HasBits(sdTerminal) {
+			return recv, ErrNotReady
+		}
+		sio.SIOCNT.SetBits(startBit)
+	} else if !waitFor(true) {
+		return recv, ErrTimeout
+	}
+	if !waitFor(false) {
+		return recv, ErrTimeout
+	}
+	if sio.SIOCNT.HasBits(errorBit) {
+		return recv, ErrTransfer
+	}
+
+	recv[0] = sio.SIOMULTI0.Get()
+	recv[1] = sio.SIOMULTI1.Get()
+	recv[2] = sio.SIOMULTI2.Get()
+	recv[3] = sio.SIOMULTI3.Get()
+	return recv, nil
+}
+
+// waitFor polls until the busy bit equals busy, or the timeout expires.
+func waitFor(busy bool) bool {
+	for i := 0; i < linkTimeout; i++ {
+		if registers.SerialCommunication.SIOCNT.HasBits(startBit) == busy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package link
+
+import (
+	"github.com/matheusmortatti/gba-go/lib/interrupts"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// Clock selects who drives the serial clock in normal mode. Exactly one
+// side of the link must use an internal clock.
+type Clock uint16
+
+const (
+	ClockExternal     Clock = 0b00
+	ClockInternal256K Clock = 0b01 // 256KHz
+	ClockInternal2M   Clock = 0b11 // 2MHz
+)
+
+const (
+	normal8  = 0 << 12
+	normal32 = 1 << 12
+	irqBit   = 1 << 14
+)
+
+var asyncDone func(recv uint32)
+
+// InitNormal8 puts the serial port in normal mode with 8-bit transfers.
+func InitNormal8(clock Clock) {
+	registers.SerialCommunication.RCNT.Set(0)
+	registers.SerialCommunication.SIOCNT.Set(uint16(clock) | normal8)
+}
+
+// InitNormal32 puts the serial port in normal mode with 32-bit transfers.
+func InitNormal32(clock Clock) {
+	registers.SerialCommunication.RCNT.Set(0)
+	registers.SerialCommunication.SIOCNT.Set(uint16(clock) | normal32)
+}
+
+// TransferDone reports whether the last transfer has completed.
+func TransferDone() bool {
+	return !registers.SerialCommunication.SIOCNT.HasBits(startBit)
+}
+
+// SendByte sends b in 8-bit mode, discarding the byte received in return.
+func SendByte(b byte) error {
+	_, err := Exchange8(b)
+	return err
+}
+
+// RecvByte receives a byte in 8-bit mode, sending 0xFF in return.
+func RecvByte() (byte, error) {
+	return Exchange8(0xFF)
+}
+
+// Exchange8 sends b and returns the byte received at the same time. With
+// an external clock it waits for the other side to clock the transfer.
+func Exchange8(b byte) (byte, error) {
+	sio := registers.SerialCommunication
+	sio.SIODATA8.Set(uint16(b))
+	sio.SIOCNT.SetBits(startBit)
+	if !waitFor(false) {
+		return 0, ErrTimeout
+	}
+	return byte(sio.SIODATA8.Get()), nil
+}
+
+// Exchange32 is Exchange8 for 32-bit mode.
+func Exchange32(w uint32) (uint32, error) {
+	sio := registers.SerialCommunication
+	sio.SIODATA32.Set(w)
+	sio.SIOCNT.SetBits(startBit)
+	if !waitFor(false) {
+		return 0, ErrTimeout
+	}
+	return sio.SIODATA32.Get(), nil
+}
+
+// StartAsync starts a normal-mode transfer of send (the low byte in 8-bit
+// mode) and returns at once. done runs from the serial interrupt with the
+// received value, so it should be short.
+func StartAsync(send uint32, done func(recv uint32)) error {
+	sio := registers.SerialCommunication
+	asyncDone = done
+	if err := interrupts.EnableInterrupt(interrupts.Serial, serialHandler); err != nil {
+		return err
+	}
+	if sio.SIOCNT.HasBits(normal32) {
+		sio.SIODATA32.Set(send)
+	} else {
+		sio.SIODATA8.Set(uint16(send & 0xFF))
+	}
+	sio.SIOCNT.SetBits(irqBit | startBit)
+	return nil
+}
+
+func serialHandler() {
+	sio := registers.SerialCommunication
+	sio.SIOCNT.ClearBits(irqBit)
+	var recv uint32
+	if sio.SIOCNT.HasBits(normal32) {
+		recv = sio.SIODATA32.Get()
+	} else {
+		recv = uint32(sio.SIODATA8.Get() & 0xFF)
+	}
+	if asyncDone != nil {
+		asyncDone(recv)
+	}
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"unsafe"
+)
+
+const (
+	EWRAMBase = 0x02000000
+	EWRAMSize = 256 * 1024
+	IWRAMBase = 0x03000000
+	IWRAMSize = 32 * 1024
+
+	arenaAlign = 4
+)
+
+// Arena is a bump allocator over a fixed block of memory, meant for
+// short-lived buffers such as per-frame DMA tables. It is not a general
+// heap: memory is only given back, all at once, by Reset.
+//
+// The block must not overlap anything else, including the Go heap and
+// stack, which TinyGo also places in work RAM.
+type Arena struct {
+	base uintptr
+	size int
+	used int
+}
+
+// NewArena returns an arena over size bytes starting at base, e.g.
+// EWRAMBase+offset. base is rounded up to a word boundary.
+func NewArena(base uintptr, size int) *Arena {
+	aligned := (base + arenaAlign - 1) &^ (arenaAlign - 1)
+	size -= int(aligned - base)
+	if size < 0 {
+		size = 0
+	}
+	return &Arena{base: aligned, size: size}
+}
+
+// Alloc returns n bytes of word-aligned memory, or nil if the arena is
+// full. The memory is not cleared.
+func (a *Arena) Alloc(n int) unsafe.Pointer {
+	if n < 0 {
+		return nil
+	}
+	n = (n + arenaAlign - 1) &^ (arenaAlign - 1)
+	if n > a.size-a.used {
+		return nil
+	}
+	p := unsafe.Pointer(a.base + uintptr(a.used))
+	a.used += n
+	return p
+}
+
+// Reset frees every allocation at once. Pointers returned by Alloc must
+// not be used afterwards.
+func (a *Arena) Reset() {
+	a.used = 0
+}
+
+// Used returns the number of bytes handed out since the last Reset.
+func (a *Arena) Used() int {
+	return a.used
+}
+
+// Size returns the capacity of the arena in bytes.
+func (a *Arena) Size() int {
+	return a.size
+}
@@ -0,0 +1,80 @@
+package mode7
+
+import (
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/bios"
+	"github.com/matheusmortatti/gba-go/lib/dma"
+	"github.com/matheusmortatti/gba-go/lib/fixed"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const (
+	screenWidth  = 240
+	screenHeight = 160
+
+	focal       = 128 // distance from the eye to the screen, in pixels
+	affineWords = 4   // BG2PA-PD, BG2X and BG2Y
+)
+
+// reciprocal[n] is 1/n as a 16.16 value.
+var reciprocal [screenHeight + 1]int32
+
+func init() {
+	for n := 1; n < len(reciprocal); n++ {
+		reciprocal[n] = (1 << 16) / int32(n)
+	}
+}
+
+// Camera7 places the viewer above the floor plane. X and Z are the ground
+// position in texture pixels, Height the distance above the floor, and
+// Angle the heading (0x10000 is a full turn).
+type Camera7 struct {
+	X, Z   fixed.Fixed
+	Height fixed.Fixed
+	Angle  uint16
+}
+
+// Mode7 renders BG2 as a perspective floor by giving every scanline its
+// own affine transform through HBlank DMA. BG2 must be an affine
+// background (mode 1 or 2) holding the floor map. Lines above Horizon
+// have no floor; hide them with a window or another layer.
+//
+// The DMA reads the per-line table from the Mode7 value, so keep it in a
+// package-level variable.
+type Mode7 struct {
+	Horizon int // scanline of the horizon, 0-159; the floor starts below it
+	Channel int // DMA channel used for the HBlank transfer, usually 0
+
+	lines [dma.HBlankLines]bios.BgAffineDest
+}
+
+// Render computes the transforms for cam and restarts the HBlank DMA. Call
+// it once per frame during VBlank.
+func (m *Mode7) Render(cam Camera7) error {
+	sin := int64(fixed.Sin(cam.Angle))
+	cos := int64(fixed.Cos(cam.Angle))
+	horizon := max(m.Horizon, 0)
+
+	for y := range m.lines {
+		line := &m.lines[y]
+		dist := y - horizon
+		if dist <= 0 {
+			*line = bios.BgAffineDest{}
+			continue
+		}
+
+		// Texture pixels covered by one screen pixel on this line, as
+		// 16.16, and its projection on both texture axes as 24.8.
+		scale := int64(cam.Height) * int64(reciprocal[dist]) >> fixed.Shift
+		pa := scale * cos >> 16
+		pc := scale * sin >> 16
+
+		line.PA = int16(pa)
+		line.PC = int16(pc)
+		line.DX = int32(int64(cam.X) - screenWidth/2*pa + focal*pc)
+		line.DY = int32(int64(cam.Z) - screenWidth/2*pc - focal*pa)
+	}
+
+	return dma.StartHBlankBlock(m.Channel, unsafe.Pointer(&m.lines[0]), affineWords, unsafe.Pointer(registers.Lcd.BG2PA))
+}
@@ -0,0 +1,42 @@
+package oam
+
+// ObjMode is the object mode of a sprite, OAM attribute 0 bits 10-11.
+type ObjMode uint16
+
+const (
+	OBJModeNormal ObjMode = iota
+	// OBJModeSemiTransparent makes the sprite a first target of alpha
+	// blending whatever BLDCNT says. It only shows once the blend
+	// coefficients are set, e.g. with effects.SetAlphaBlend.
+	OBJModeSemiTransparent
+	// OBJModeWindow hides the sprite and uses it as the OBJ window mask
+	// (see effects.OBJWindow).
+	OBJModeWindow
+)
+
+const (
+	objModeShift = 10
+	objModeMask  = 0b11 << objModeShift
+	objMosaicBit = 1 << 12
+)
+
+// OBJModeAttr0 returns the OAM attribute 0 value attr0 with its object
+// mode set to mode.
+func OBJModeAttr0(attr0 uint16, mode ObjMode) uint16 {
+	return attr0&^objModeMask | uint16(mode)<<objModeShift&objModeMask
+}
+
+// OBJWindowAttr0 returns attr0 with the object mode set to OBJ window,
+// making that sprite part of the OBJ window mask.
+func OBJWindowAttr0(attr0 uint16) uint16 {
+	return OBJModeAttr0(attr0, OBJModeWindow)
+}
+
+// OBJMosaicAttr0 returns attr0 with the sprite's mosaic bit set or
+// cleared. The block size comes from effects.SetOBJMosaic.
+func OBJMosaicAttr0(attr0 uint16, on bool) uint16 {
+	if on {
+		return attr0 | objMosaicBit
+	}
+	return attr0 &^ objMosaicBit
+}
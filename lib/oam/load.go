@@ -0,0 +1,50 @@
+package oam
+
+import (
+	"errors"
+	"runtime/volatile"
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const (
+	objVRAMBase = 0x06010000
+	tileSize    = 32 // bytes in a 4bpp tile
+	objTiles    = 1024
+
+	// firstBitmapTile is the first sprite tile not covered by the frame
+	// buffers of bitmap modes 3-5.
+	firstBitmapTile = 512
+	firstBitmapMode = 3
+)
+
+var (
+	ErrOutOfRange = errors.New("oam: tiles outside the OBJ VRAM area")
+	ErrOddLength  = errors.New("oam: tile data must have an even length")
+)
+
+// LoadSpriteTiles copies 4bpp tile data into sprite VRAM starting at tile
+// startTile. In bitmap modes the lower half of sprite VRAM holds the frame
+// buffer, so startTile must then be 512 or higher.
+func LoadSpriteTiles(startTile int, data []uint8) error {
+	// VRAM ignores 8-bit writes, so the data is written a halfword at a time.
+	if len(data)%2 != 0 {
+		return ErrOddLength
+	}
+	first := 0
+	if registers.Lcd.Mode() >= firstBitmapMode {
+		first = firstBitmapTile
+	}
+	end := startTile*tileSize + len(data)
+	if startTile < first || end > objTiles*tileSize {
+		return ErrOutOfRange
+	}
+
+	addr := uintptr(objVRAMBase + startTile*tileSize)
+	for i := 0; i < len(data); i += 2 {
+		v := uint16(data[i]) | uint16(data[i+1])<<8
+		volatile.StoreUint16((*uint16)(unsafe.Pointer(addr+uintptr(i))), v)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package oam
+
+import (
+	"errors"
+
+	"github.com/matheusmortatti/gba-go/lib/video"
+)
+
+// sheetWidth is the width in tile slots of the sprite tile sheet in 2D
+// mapping.
+const sheetWidth = 32
+
+var ErrInvalidBPP = errors.New("oam: bits per pixel must be 4 or 8")
+
+// TileIndexFor returns the tile index of the cell cellX, cellY of a sprite
+// whose top-left tile is baseTile and which is spriteWidthTiles tiles wide.
+// bpp is 4 or 8. Indices count 32-byte slots, so an 8bpp tile takes two of
+// them.
+//
+// In 1D mapping a sprite's tiles follow each other, so rows are
+// spriteWidthTiles tiles apart; in 2D mapping they are a full sheet row
+// (32 slots) apart.
+func TileIndexFor(baseTile, cellX, cellY, spriteWidthTiles, bpp int, mapping video.OBJMapping) (int, error) {
+	var slots int
+	switch bpp {
+	case 4:
+		slots = 1
+	case 8:
+		slots = 2
+	default:
+		return 0, ErrInvalidBPP
+	}
+	if mapping == video.OBJMapping1D {
+		return baseTile + (cellY*spriteWidthTiles+cellX)*slots, nil
+	}
+	return baseTile + cellY*sheetWidth + cellX*slots, nil
+}
@@ -84,3 +84,17 @@ var Lcd = &lcd{
 	BLDALPHA: (*volatile.Register16)(unsafe.Pointer(uintptr(0x04000052))),
 	BLDY:     (*volatile.Register16)(unsafe.Pointer(uintptr(0x04000054))),
 }
+
+type bgOffset struct {
+	HOFS *volatile.Register16 // X-Offset
+	VOFS *volatile.Register16 // Y-Offset
+}
+
+// BGOffset holds the scroll registers of each text background, indexed by
+// background number.
+var BGOffset = [4]bgOffset{
+	{Lcd.BG0HOFS, Lcd.BG0VOFS},
+	{Lcd.BG1HOFS, Lcd.BG1VOFS},
+	{Lcd.BG2HOFS, Lcd.BG2VOFS},
+	{Lcd.BG3HOFS, Lcd.BG3VOFS},
+}
@@ -0,0 +1,57 @@
+package registers
+
+// DISPCNT fields. The setters read-modify-write the register so the other
+// fields keep their values.
+const (
+	DISPCNTModeMask    = 0b111
+	DISPCNTPage        = 1 << 4
+	DISPCNTOBJMapping  = 1 << 6
+	DISPCNTForcedBlank = 1 << 7
+	DISPCNTBG0         = 1 << 8 // BG1-3 and OBJ follow in bits 9-12
+	DISPCNTWin0        = 1 << 13
+	DISPCNTWin1        = 1 << 14
+	DISPCNTOBJWin      = 1 << 15
+)
+
+// Mode returns the video mode, 0-5.
+func (l *lcd) Mode() int {
+	return int(l.DISPCNT.Get() & DISPCNTModeMask)
+}
+
+// SetMode sets the video mode. Only the low 3 bits of mode are used, so
+// the other DISPCNT fields are never touched.
+func (l *lcd) SetMode(mode int) {
+	l.DISPCNT.ReplaceBits(uint16(mode)&DISPCNTModeMask, DISPCNTModeMask, 0)
+}
+
+// BGEnabled reports whether background bg (0-3) is displayed.
+func (l *lcd) BGEnabled(bg int) bool {
+	return bg >= 0 && bg <= 3 && l.DISPCNT.HasBits(DISPCNTBG0<<bg)
+}
+
+// SetBGEnabled shows or hides background bg (0-3). Other values are
+// ignored.
+func (l *lcd) SetBGEnabled(bg int, on bool) {
+	if bg < 0 || bg > 3 {
+		return
+	}
+	l.setDispcnt(DISPCNTBG0<<bg, on)
+}
+
+// SetOBJMapping selects 1D (true) or 2D (false) sprite tile mapping.
+func (l *lcd) SetOBJMapping(oneD bool) {
+	l.setDispcnt(DISPCNTOBJMapping, oneD)
+}
+
+// SetForcedBlank turns forced blank on or off.
+func (l *lcd) SetForcedBlank(on bool) {
+	l.setDispcnt(DISPCNTForcedBlank, on)
+}
+
+func (l *lcd) setDispcnt(bits uint16, on bool) {
+	if on {
+		l.DISPCNT.SetBits(bits)
+	} else {
+		l.DISPCNT.ClearBits(bits)
+	}
+}
@@ -0,0 +1,20 @@
+package registers
+
+import (
+	"runtime/volatile"
+	"unsafe"
+)
+
+type systemControl struct {
+	WAITCNT *volatile.Register16 // Game Pak Waitstate Control
+	POSTFLG *volatile.Register8  // Undocumented - Post Boot Flag
+	HALTCNT *volatile.Register8  // Undocumented - Power Down Control
+	MEMCNT  *volatile.Register32 // Undocumented - Internal Memory Control
+}
+
+var SystemControl = &systemControl{
+	WAITCNT: (*volatile.Register16)(unsafe.Pointer(uintptr(0x04000204))),
+	POSTFLG: (*volatile.Register8)(unsafe.Pointer(uintptr(0x04000300))),
+	HALTCNT: (*volatile.Register8)(unsafe.Pointer(uintptr(0x04000301))),
+	MEMCNT:  (*volatile.Register32)(unsafe.Pointer(uintptr(0x04000800))),
+}
@@ -0,0 +1,49 @@
+package rng
+
+// Rand is a xorshift32 generator: small, fast and fully determined by its
+// seed, so runs can be replayed. It is not safe for concurrent use.
+type Rand struct {
+	state uint32
+}
+
+// defaultSeed replaces a zero seed, which xorshift can never leave.
+const defaultSeed = 0x9E3779B9
+
+// New returns a generator seeded with seed.
+func New(seed uint32) *Rand {
+	r := &Rand{}
+	r.Seed(seed)
+	return r
+}
+
+// Seed resets the generator to the sequence for seed.
+func (r *Rand) Seed(seed uint32) {
+	if seed == 0 {
+		seed = defaultSeed
+	}
+	r.state = seed
+}
+
+// Next returns the next 32-bit value in the sequence.
+func (r *Rand) Next() uint32 {
+	x := r.state
+	x ^= x << 13
+	x ^= x >> 17
+	x ^= x << 5
+	r.state = x
+	return x
+}
+
+// IntN returns a value in [0, n). It returns 0 if n <= 0.
+func (r *Rand) IntN(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	// Scale instead of using %, which is slow without a hardware divider.
+	return int(uint64(r.Next()) * uint64(n) >> 32)
+}
+
+// Range returns a value in [min, max). It returns min if max <= min.
+func (r *Rand) Range(min, max int) int {
+	return min + r.IntN(max-min)
+}
@@ -0,0 +1,19 @@
+package save
+
+// SaveBackend is the save memory of a cartridge, so games can work with
+// SRAM and Flash through the same calls.
+type SaveBackend interface {
+	Read(offset int, p []byte) error
+	Write(offset int, p []byte) error
+	Size() int
+}
+
+type sram struct{}
+
+// SRAM is the battery-backed SRAM backend, using the package-level Read
+// and Write.
+var SRAM SaveBackend = sram{}
+
+func (sram) Read(offset int, p []byte) error  { return Read(offset, p) }
+func (sram) Write(offset int, p []byte) error { return Write(offset, p) }
+func (sram) Size() int                        { return SRAMSize }
@@ -0,0 +1,162 @@
+package save
+
+import (
+	"errors"
+)
+
+// Encoded records are laid out as
+//
+//	version  1 byte
+//	length   2 bytes, payload size
+//	payload  length bytes
+//	crc      2 bytes, CRC-16/CCITT of everything before it
+//
+// with all multi-byte values little-endian.
+const recordOverhead = 5
+
+var (
+	ErrVersion   = errors.New("save: record version mismatch")
+	ErrShortData = errors.New("save: record data too short")
+)
+
+// Record is a save struct that writes and reads its own fields in a fixed
+// order. Bump Version whenever the layout changes so old saves are
+// rejected instead of misread.
+type Record interface {
+	Version() uint8
+	MarshalSave(w *Writer)
+	UnmarshalSave(r *Reader)
+}
+
+// Encode serializes r with its version and a checksum.
+func Encode(r Record) ([]byte, error) {
+	w := &Writer{data: make([]byte, 3, 64)}
+	r.MarshalSave(w)
+	n := len(w.data) - 3
+	if n > 0xFFFF {
+		return nil, ErrTooLarge
+	}
+	w.data[0] = r.Version()
+	w.data[1] = byte(n)
+	w.data[2] = byte(n >> 8)
+	w.Uint16(crc16(w.data))
+	return w.data, nil
+}
+
+// Decode checks the version and checksum of data and fills r from it.
+func Decode(data []byte, r Record) error {
+	if len(data) < recordOverhead {
+		return ErrShortData
+	}
+	n := int(data[1]) | int(data[2])<<8
+	if len(data) < n+recordOverhead {
+		return ErrShortData
+	}
+	body := data[:3+n]
+	sum := uint16(data[3+n]) | uint16(data[4+n])<<8
+	if crc16(body) != sum {
+		return ErrCorrupt
+	}
+	if data[0] != r.Version() {
+		return ErrVersion
+	}
+	rd := &Reader{data: body[3:]}
+	r.UnmarshalSave(rd)
+	return rd.err
+}
+
+// Writer appends little-endian fields for Record.MarshalSave.
+type Writer struct {
+	data []byte
+}
+
+func (w *Writer) Uint8(v uint8) {
+	w.data = append(w.data, v)
+}
+
+func (w *Writer) Uint16(v uint16) {
+	w.data = append(w.data, byte(v), byte(v>>8))
+}
+
+func (w *Writer) Uint32(v uint32) {
+	w.data = append(w.data, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func (w *Writer) Bool(v bool) {
+	if v {
+		w.Uint8(1)
+	} else {
+		w.Uint8(0)
+	}
+}
+
+// Bytes writes p as is, so the reader has to know its length.
+func (w *Writer) Bytes(p []byte) {
+	w.data = append(w.data, p...)
+}
+
+// Reader reads fields back for Record.UnmarshalSave. Once the data runs
+// out every read returns zero and Decode reports ErrShortData.
+type Reader struct {
+	data []byte
+	err  error
+}
+
+func (r *Reader) Uint8() uint8 {
+	p := r.next(1)
+	if p == nil {
+		return 0
+	}
+	return p[0]
+}
+
+func (r *Reader) Uint16() uint16 {
+	p := r.next(2)
+	if p == nil {
+		return 0
+	}
+	return uint16(p[0]) | uint16(p[1])<<8
+}
+
+func (r *Reader) Uint32() uint32 {
+	p := r.next(4)
+	if p == nil {
+		return 0
+	}
+	return uint32(p[0]) | uint32(p[1])<<8 | uint32(p[2])<<16 | uint32(p[3])<<24
+}
+
+func (r *Reader) Bool() bool {
+	return r.Uint8() != 0
+}
+
+// Bytes fills p from the data.
+func (r *Reader) Bytes(p []byte) {
+	copy(p, r.next(len(p)))
+}
+
+func (r *Reader) next(n int) []byte {
+	if r.err != nil || len(r.data) < n {
+		r.err = ErrShortData
+		return nil
+	}
+	p := r.data[:n]
+	r.data = r.data[n:]
+	return p
+}
+
+// crc16 is CRC-16/CCITT-FALSE (polynomial 0x1021, initial value 0xFFFF).
+func crc16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,180 @@
+package save
+
+import (
+	"errors"
+	"runtime/volatile"
+	"unsafe"
+)
+
+const (
+	flashBank       = 64 * 1024
+	FlashSectorSize = 4 * 1024
+
+	cmdAddr1 = 0x5555
+	cmdAddr2 = 0x2AAA
+
+	cmdErase      = 0x80
+	cmdEraseSect  = 0x30
+	cmdWrite      = 0xA0
+	cmdSwitchBank = 0xB0
+	cmdEnterID    = 0x90
+	cmdExitID     = 0xF0
+
+	flashTimeout = 0x100000 // polls before giving up
+)
+
+var (
+	ErrNoFlash      = errors.New("save: no known flash chip found")
+	ErrFlashTimeout = errors.New("save: flash operation timed out")
+	ErrInvalidSect  = errors.New("save: sector out of range")
+)
+
+// Flash is a 64KB or 128KB flash save chip. Flash bytes can only be
+// programmed after their 4KB sector has been erased.
+type Flash struct {
+	id   uint16 // device code << 8 | manufacturer code
+	size int
+	bank int
+}
+
+// flashSizes maps chip IDs to their capacity. Atmel chips program whole
+// 128-byte pages instead of bytes and are not supported.
+var flashSizes = map[uint16]int{
+	0xD4BF: 64 * 1024,  // SST
+	0x1CC2: 64 * 1024,  // Macronix
+	0x1B32: 64 * 1024,  // Panasonic
+	0x1362: 128 * 1024, // Sanyo
+	0x09C2: 128 * 1024, // Macronix
+}
+
+var _ SaveBackend = (*Flash)(nil)
+
+// sectorBuffer holds a sector during Write, avoiding a 4KB allocation.
+var sectorBuffer [FlashSectorSize]byte
+
+// DetectFlash reads the chip ID and returns a driver for it.
+func DetectFlash() (*Flash, error) {
+	flashCommand(cmdEnterID)
+	id := uint16(flashRead(0)) | uint16(flashRead(1))<<8
+	flashCommand(cmdExitID)
+
+	size, ok := flashSizes[id]
+	if !ok {
+		return nil, ErrNoFlash
+	}
+	return &Flash{id: id, size: size, bank: -1}, nil
+}
+
+// ID returns the chip ID, device code in the high byte and manufacturer
+// code in the low byte.
+func (f *Flash) ID() uint16 {
+	return f.id
+}
+
+// Size returns the capacity of the chip in bytes.
+func (f *Flash) Size() int {
+	return f.size
+}
+
+// EraseSector sets every byte of sector n to 0xFF.
+func (f *Flash) EraseSector(n int) error {
+	if n < 0 || n >= f.size/FlashSectorSize {
+		return ErrInvalidSect
+	}
+	addr := f.selectBank(n * FlashSectorSize)
+	flashCommand(cmdErase)
+	flashWrite(cmdAddr1, 0xAA)
+	flashWrite(cmdAddr2, 0x55)
+	flashWrite(addr, cmdEraseSect)
+	return flashWait(addr, 0xFF)
+}
+
+// WriteSector erases sector n and programs it with data, which may be
+// shorter than a sector.
+func (f *Flash) WriteSector(n int, data []byte) error {
+	if len(data) > FlashSectorSize {
+		return ErrOutOfRange
+	}
+	if err := f.EraseSector(n); err != nil {
+		return err
+	}
+	addr := f.selectBank(n * FlashSectorSize)
+	for i, b := range data {
+		flashCommand(cmdWrite)
+		flashWrite(addr+i, b)
+		if err := flashWait(addr+i, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read fills p with the flash contents starting at offset.
+func (f *Flash) Read(offset int, p []byte) error {
+	if offset < 0 || offset+len(p) > f.size {
+		return ErrOutOfRange
+	}
+	for i := range p {
+		p[i] = flashRead(f.selectBank(offset + i))
+	}
+	return nil
+}
+
+// Write stores p at offset, erasing and rewriting every sector it touches
+// while keeping the bytes around it.
+func (f *Flash) Write(offset int, p []byte) error {
+	if offset < 0 || offset+len(p) > f.size {
+		return ErrOutOfRange
+	}
+	for len(p) > 0 {
+		sector := offset / FlashSectorSize
+		start := offset % FlashSectorSize
+		if err := f.Read(sector*FlashSectorSize, sectorBuffer[:]); err != nil {
+			return err
+		}
+		n := copy(sectorBuffer[start:], p)
+		if err := f.WriteSector(sector, sectorBuffer[:]); err != nil {
+			return err
+		}
+		offset += n
+		p = p[n:]
+	}
+	return nil
+}
+
+// selectBank switches to the 64KB bank holding offset, on chips that have
+// two, and returns the offset within the bank.
+func (f *Flash) selectBank(offset int) int {
+	bank := offset / flashBank
+	if f.size > flashBank && bank != f.bank {
+		flashCommand(cmdSwitchBank)
+		flashWrite(0, byte(bank))
+		f.bank = bank
+	}
+	return offset % flashBank
+}
+
+// flashCommand sends the unlock sequence followed by cmd.
+func flashCommand(cmd byte) {
+	flashWrite(cmdAddr1, 0xAA)
+	flashWrite(cmdAddr2, 0x55)
+	flashWrite(cmdAddr1, cmd)
+}
+
+// flashWait polls until the byte at addr reads back as want.
+func flashWait(addr int, want byte) error {
+	for i := 0; i < flashTimeout; i++ {
+		if flashRead(addr) == want {
+			return nil
+		}
+	}
+	return ErrFlashTimeout
+}
+
+func flashRead(addr int) byte {
+	return volatile.LoadUint8((*uint8)(unsafe.Pointer(uintptr(sramBase + addr))))
+}
+
+func flashWrite(addr int, v byte) {
+	volatile.StoreUint8((*uint8)(unsafe.Pointer(uintptr(sramBase+addr))), v)
+}
@@ -0,0 +1,96 @@
+package save
+
+import (
+	"errors"
+	"io"
+)
+
+// A slot starts with an 8-byte header: a 4-byte magic value, the data
+// length and a Fletcher-16 checksum of the data, both little-endian.
+const (
+	slotMagic  = "GBAS"
+	headerSize = 8
+)
+
+var (
+	ErrNoSave   = errors.New("save: slot holds no save")
+	ErrCorrupt  = errors.New("save: checksum mismatch")
+	ErrTooLarge = errors.New("save: data does not fit in slot")
+)
+
+// SaveSlot is a region of save memory holding one validated save.
+type SaveSlot struct {
+	Backend SaveBackend // save memory holding the slot; nil means SRAM
+	Offset  int         // start of the slot in the backend
+	Size    int         // slot size in bytes, including the header
+}
+
+// Save writes data to the slot with a header and checksum.
+func (s SaveSlot) Save(data []byte) error {
+	if len(data) > s.Size-headerSize || len(data) > 0xFFFF {
+		return ErrTooLarge
+	}
+	sum := checksum(data)
+	header := []byte{
+		slotMagic[0], slotMagic[1], slotMagic[2], slotMagic[3],
+		byte(len(data)), byte(len(data) >> 8),
+		byte(sum), byte(sum >> 8),
+	}
+	// Write the data before the header so an interrupted save is not
+	// mistaken for a complete one.
+	b := s.backend()
+	if err := b.Write(s.Offset, make([]byte, headerSize)); err != nil {
+		return err
+	}
+	if err := b.Write(s.Offset+headerSize, data); err != nil {
+		return err
+	}
+	return b.Write(s.Offset, header)
+}
+
+// Load reads the saved data into p and returns its length. It fails with
+// ErrNoSave if the slot was never written and ErrCorrupt if the data does
+// not match its checksum.
+func (s SaveSlot) Load(p []byte) (int, error) {
+	b := s.backend()
+	var header [headerSize]byte
+	if err := b.Read(s.Offset, header[:]); err != nil {
+		return 0, err
+	}
+	if string(header[:4]) != slotMagic {
+		return 0, ErrNoSave
+	}
+	n := int(header[4]) | int(header[5])<<8
+	sum := uint16(header[6]) | uint16(header[7])<<8
+	if n > s.Size-headerSize {
+		return 0, ErrCorrupt
+	}
+	if n > len(p) {
+		return 0, io.ErrShortBuffer
+	}
+	if err := b.Read(s.Offset+headerSize, p[:n]); err != nil {
+		return 0, err
+	}
+	if checksum(p[:n]) != sum {
+		return 0, ErrCorrupt
+	}
+	return n, nil
+}
+
+func (s SaveSlot) backend() SaveBackend {
+	if s.Backend == nil {
+		return SRAM
+	}
+	return s.Backend
+}
+
+// checksum is Fletcher-16, which unlike a plain sum also catches swapped
+// bytes.
+func checksum(data []byte) uint16 {
+	var a, b uint16
+	for _, v := range data {
+		a = (a + uint16(v)) % 255
+		b = (b + a) % 255
+	}
+	return b<<8 | a
+}
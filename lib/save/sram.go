@@ -0,0 +1,60 @@
+package save
+
+import (
+	"errors"
+	"runtime/volatile"
+	"unsafe"
+)
+
+const (
+	sramBase = 0x0E000000
+	SRAMSize = 32 * 1024
+)
+
+var ErrOutOfRange = errors.New("save: access outside of save memory")
+
+// SRAM is only wired to the lower 8 data lines, so every access below
+// goes through a single byte. 16 or 32-bit reads and writes return or
+// store garbage.
+
+// ReadByte returns the SRAM byte at offset, or 0 if offset is out of range.
+func ReadByte(offset int) uint8 {
+	if offset < 0 || offset >= SRAMSize {
+		return 0
+	}
+	return volatile.LoadUint8(sramAddr(offset))
+}
+
+// WriteByte stores v at offset. Out of range offsets are ignored.
+func WriteByte(offset int, v uint8) {
+	if offset < 0 || offset >= SRAMSize {
+		return
+	}
+	volatile.StoreUint8(sramAddr(offset), v)
+}
+
+// Read fills p with the SRAM contents starting at offset.
+func Read(offset int, p []byte) error {
+	if offset < 0 || offset+len(p) > SRAMSize {
+		return ErrOutOfRange
+	}
+	for i := range p {
+		p[i] = volatile.LoadUint8(sramAddr(offset + i))
+	}
+	return nil
+}
+
+// Write stores p in SRAM starting at offset.
+func Write(offset int, p []byte) error {
+	if offset < 0 || offset+len(p) > SRAMSize {
+		return ErrOutOfRange
+	}
+	for i, b := range p {
+		volatile.StoreUint8(sramAddr(offset+i), b)
+	}
+	return nil
+}
+
+func sramAddr(offset int) *uint8 {
+	return (*uint8)(unsafe.Pointer(uintptr(sramBase + offset)))
+}
@@ -0,0 +1,121 @@
+package sound
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/matheusmortatti/gba-go/lib/dma"
+	"github.com/matheusmortatti/gba-go/lib/registers"
+	"github.com/matheusmortatti/gba-go/lib/timer"
+)
+
+// FifoChannel selects one of the two DirectSound channels.
+type FifoChannel int
+
+const (
+	FifoA FifoChannel = iota // fed by DMA1, clocked by timer 0
+	FifoB                    // fed by DMA2, clocked by timer 1
+)
+
+const (
+	fifoAFull  = 1 << 2
+	fifoAOut   = 1<<8 | 1<<9 // right and left
+	fifoAReset = 1 << 11
+	fifoBFull  = 1 << 3
+	fifoBOut   = 1<<12 | 1<<13
+	fifoBTimer = 1 << 14
+	fifoBReset = 1 << 15
+)
+
+var (
+	ErrInvalidFifo = errors.New("sound: unknown FIFO channel")
+	ErrEmptySample = errors.New("sound: sample is empty")
+	ErrInvalidRate = errors.New("sound: sample rate out of range")
+)
+
+type fifo struct {
+	dma     *dma.Channel
+	timer   *timer.Timer
+	address unsafe.Pointer
+	control uint16 // SOUNDCNT_H bits for this FIFO
+	reset   uint16
+	buffer  []uint32 // aligned copy of the sample, if one was needed
+	sample  []int8   // keeps the caller's data alive while the DMA reads it
+}
+
+var fifos = [...]*fifo{
+	FifoA: {
+		dma:     dma.Channel1,
+		timer:   timer.Timer0,
+		address: unsafe.Pointer(registers.Sound.FIFO_A),
+		control: fifoAFull | fifoAOut,
+		reset:   fifoAReset,
+	},
+	FifoB: {
+		dma:     dma.Channel2,
+		timer:   timer.Timer1,
+		address: unsafe.Pointer(registers.Sound.FIFO_B),
+		control: fifoBFull | fifoBOut | fifoBTimer,
+		reset:   fifoBReset,
+	},
+}
+
+// burstBytes is the amount of sample data the DMA moves per FIFO request.
+const burstBytes = 16
+
+// PlaySample plays signed 8-bit PCM data at sampleRate Hz on the given
+// FIFO. The FIFO is fed 16 bytes at a time, so data whose length is not a
+// multiple of 16, or which is not word-aligned, is copied into a
+// silence-padded buffer first.
+//
+// Playback stops by itself at the end of data, using the DMA interrupt of
+// the FIFO's channel. The last few samples still queued in the FIFO are
+// cut off.
+func PlaySample(data []int8, sampleRate int, channel FifoChannel) error {
+	if channel != FifoA && channel != FifoB {
+		return ErrInvalidFifo
+	}
+	if len(data) == 0 {
+		return ErrEmptySample
+	}
+	f := fifos[channel]
+	f.stop()
+
+	src := unsafe.Pointer(&data[0])
+	size := len(data)
+	if size%burstBytes != 0 || uintptr(src)%4 != 0 {
+		size = (size + burstBytes - 1) / burstBytes * burstBytes
+		f.buffer = make([]uint32, size/4)
+		copy(unsafe.Slice((*int8)(unsafe.Pointer(&f.buffer[0])), len(data)), data)
+		src = unsafe.Pointer(&f.buffer[0])
+	}
+	if err := f.timer.SetFrequency(sampleRate); err != nil {
+		return errors.Join(ErrInvalidRate, err)
+	}
+	f.sample = data
+
+	registers.Sound.SOUNDCNT_X.SetBits(masterEnable)
+	registers.Sound.SOUNDCNT_H.SetBits(f.control | f.reset)
+	if err := f.dma.StartSoundFIFO(src, f.address, size/4, f.stop); err != nil {
+		return err
+	}
+	f.timer.Start()
+	return nil
+}
+
+// Stop ends playback on the given FIFO, releasing its DMA channel and timer.
+func Stop(channel FifoChannel) {
+	if channel != FifoA && channel != FifoB {
+		return
+	}
+	fifos[channel].stop()
+}
+
+func (f *fifo) stop() {
+	f.timer.Stop()
+	f.dma.Stop()
+	registers.Sound.SOUNDCNT_H.ClearBits(f.control)
+	registers.Sound.SOUNDCNT_H.SetBits(f.reset)
+	f.buffer = nil
+	f.sample = nil
+}
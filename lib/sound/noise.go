@@ -0,0 +1,93 @@
+package sound
+
+import (
+	"errors"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const (
+	width7Bit = 1 << 3
+	timedBit  = 1 << 14
+
+	// The length counter counts 1/256s units, up to 64 of them.
+	maxLengthUnits = 64
+	framesPerSec   = 60
+)
+
+var (
+	ErrInvalidNoiseClock   = errors.New("sound: noise divider must be 0-7 and prescaler 0-13")
+	ErrInvalidCounterWidth = errors.New("sound: noise counter width must be 7 or 15")
+)
+
+type noise struct {
+	envelope uint16 // SOUND4CNT_L
+	control  uint16 // SOUND4CNT_H
+}
+
+// Noise is PSG channel 4, a pseudo-random noise generator used for
+// percussion and effects. Settings are written to the hardware on the next
+// Play or PlayBurst.
+var Noise = &noise{
+	envelope: 15 << 12, // full volume, no envelope
+}
+
+// SetEnvelope sets the starting volume (0-15), whether it fades up or
+// down, and the step time (0-7, in 1/64s units; 0 keeps the volume fixed).
+func (n *noise) SetEnvelope(vol, dir, step int) {
+	n.envelope = n.envelope&0x3F |
+		uint16(clamp(step, 0, 7))<<8 |
+		uint16(clamp(dir, EnvelopeDecrease, EnvelopeIncrease))<<11 |
+		uint16(clamp(vol, 0, 15))<<12
+}
+
+// SetFrequency sets the noise clock to 524288Hz / r / 2^(prescaler+1),
+// where r is clockDivider (0-7) and a divider of 0 counts as 0.5.
+func (n *noise) SetFrequency(clockDivider, prescaler int) error {
+	if clockDivider < 0 || clockDivider > 7 || prescaler < 0 || prescaler > 13 {
+		return ErrInvalidNoiseClock
+	}
+	n.control = n.control&^0xF7 | uint16(clockDivider) | uint16(prescaler)<<4
+	return nil
+}
+
+// SetCounterWidth selects a 15-bit (hiss) or 7-bit (metallic) generator.
+func (n *noise) SetCounterWidth(bits int) error {
+	switch bits {
+	case 7:
+		n.control |= width7Bit
+	case 15:
+		n.control &^= width7Bit
+	default:
+		return ErrInvalidCounterWidth
+	}
+	return nil
+}
+
+// Play starts the noise until Stop is called.
+func (n *noise) Play() {
+	n.start(n.envelope, n.control&^timedBit)
+}
+
+// PlayBurst plays the noise for lengthFrames frames and lets the hardware
+// stop it. The length counter is limited to a quarter second, so
+// lengthFrames is clamped to 1-15.
+func (n *noise) PlayBurst(lengthFrames int) {
+	units := (clamp(lengthFrames, 1, 15)*256 + framesPerSec - 1) / framesPerSec
+	if units > maxLengthUnits {
+		units = maxLengthUnits
+	}
+	length := uint16(maxLengthUnits - units)
+	n.start(n.envelope&^0x3F|length, n.control|timedBit)
+}
+
+// Stop silences the channel.
+func (n *noise) Stop() {
+	disableChannel(4)
+}
+
+func (n *noise) start(envelope, control uint16) {
+	enableChannel(4)
+	registers.Sound.SOUND4CNT_L.Set(envelope)
+	registers.Sound.SOUND4CNT_H.Set(control | restartBit)
+}
@@ -0,0 +1,46 @@
+package sound
+
+import (
+	"errors"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// Envelope directions for SetEnvelope.
+const (
+	EnvelopeDecrease = 0
+	EnvelopeIncrease = 1
+)
+
+const (
+	masterEnable = 1 << 7
+	psgVolume    = 0x77 // maximum left and right PSG volume in SOUNDCNT_L
+	psgFull      = 2    // 100% PSG mix in SOUNDCNT_H
+	restartBit   = 1 << 15
+)
+
+var ErrInvalidFrequency = errors.New("sound: frequency out of range")
+
+// enableChannel turns on the sound hardware and routes PSG channel n (1-4)
+// to both speakers. The registers of the PSG channels only accept writes
+// while the master enable bit is set.
+func enableChannel(n uint) {
+	registers.Sound.SOUNDCNT_X.SetBits(masterEnable)
+	registers.Sound.SOUNDCNT_H.ReplaceBits(psgFull, 0b11, 0)
+	registers.Sound.SOUNDCNT_L.SetBits(psgVolume | 1<<(7+n) | 1<<(11+n))
+}
+
+// disableChannel stops routing PSG channel n (1-4) to the speakers.
+func disableChannel(n uint) {
+	registers.Sound.SOUNDCNT_L.ClearBits(1<<(7+n) | 1<<(11+n))
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
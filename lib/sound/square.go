@@ -0,0 +1,76 @@
+package sound
+
+import (
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// Duty cycles for SetDuty.
+const (
+	Duty12 = iota // 12.5%
+	Duty25
+	Duty50
+	Duty75
+)
+
+// Sweep directions for SetSweep.
+const (
+	SweepIncrease = 0
+	SweepDecrease = 1
+)
+
+type square1 struct {
+	sweep    uint16 // SOUND1CNT_L
+	envelope uint16 // SOUND1CNT_H
+	rate     uint16 // SOUND1CNT_X
+}
+
+// Square1 is PSG channel 1, a square wave with frequency sweep. Settings
+// are written to the hardware on the next Play.
+var Square1 = &square1{
+	envelope: 15 << 12,          // full volume, no envelope
+	rate:     2048 - 131072/440, // A4
+}
+
+// SetFrequency sets the tone in Hz, from 64 to 131072.
+func (s *square1) SetFrequency(hz int) error {
+	if hz < 64 || hz > 131072 {
+		return ErrInvalidFrequency
+	}
+	s.rate = uint16(2048 - 131072/hz)
+	return nil
+}
+
+// SetDuty selects the wave duty cycle (Duty12 to Duty75).
+func (s *square1) SetDuty(duty int) {
+	s.envelope = s.envelope&^(0b11<<6) | uint16(clamp(duty, Duty12, Duty75))<<6
+}
+
+// SetEnvelope sets the starting volume (0-15), whether it fades up or
+// down, and the step time (0-7, in 1/64s units; 0 keeps the volume fixed).
+func (s *square1) SetEnvelope(initialVol, direction, stepTime int) {
+	s.envelope = s.envelope&0xFF |
+		uint16(clamp(stepTime, 0, 7))<<8 |
+		uint16(clamp(direction, EnvelopeDecrease, EnvelopeIncrease))<<11 |
+		uint16(clamp(initialVol, 0, 15))<<12
+}
+
+// SetSweep bends the frequency every time/128s (time 0-7, 0 disables) by
+// rate/2^shifts (shifts 0-7) in the given direction.
+func (s *square1) SetSweep(shifts, direction, time int) {
+	s.sweep = uint16(clamp(shifts, 0, 7)) |
+		uint16(clamp(direction, SweepIncrease, SweepDecrease))<<3 |
+		uint16(clamp(time, 0, 7))<<4
+}
+
+// Play (re)starts the tone with the current settings.
+func (s *square1) Play() {
+	enableChannel(1)
+	registers.Sound.SOUND1CNT_L.Set(s.sweep)
+	registers.Sound.SOUND1CNT_H.Set(s.envelope)
+	registers.Sound.SOUND1CNT_X.Set(s.rate | restartBit)
+}
+
+// Stop silences the channel.
+func (s *square1) Stop() {
+	disableChannel(1)
+}
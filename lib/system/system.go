@@ -0,0 +1,49 @@
+package system
+
+import (
+	"errors"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const (
+	prefetchBit = 1 << 14
+
+	ws0FirstShift  = 2
+	ws0SecondShift = 4
+)
+
+var ErrInvalidWaitStates = errors.New("system: ROM wait states must be 2, 3, 4 or 8 then 1 or 2")
+
+// firstAccess maps WAITCNT values to first-access wait cycles.
+var firstAccess = [4]int{4, 3, 2, 8}
+
+// EnablePrefetch turns on the game pak prefetch buffer, which fetches ROM
+// while the CPU is busy and speeds up code running from ROM.
+func EnablePrefetch() {
+	registers.SystemControl.WAITCNT.SetBits(prefetchBit)
+}
+
+// DisablePrefetch turns the game pak prefetch buffer off.
+func DisablePrefetch() {
+	registers.SystemControl.WAITCNT.ClearBits(prefetchBit)
+}
+
+// SetROMWaitStates sets the wait cycles of the main ROM region for the
+// first (random) access, 2, 3, 4 or 8, and the following sequential
+// accesses, 1 or 2. The BIOS default is 4 and 2; most carts run at 3 and 1.
+func SetROMWaitStates(first, second int) error {
+	n := -1
+	for i, cycles := range firstAccess {
+		if cycles == first {
+			n = i
+		}
+	}
+	if n < 0 || second < 1 || second > 2 {
+		return ErrInvalidWaitStates
+	}
+	w := registers.SystemControl.WAITCNT
+	w.ReplaceBits(uint16(n), 0b11, ws0FirstShift)
+	w.ReplaceBits(uint16(2-second), 0b1, ws0SecondShift)
+	return nil
+}
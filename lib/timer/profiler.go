@@ -0,0 +1,54 @@
+package timer
+
+// PerformanceCounter measures CPU cycles using timers 2 and 3 cascaded into
+// a single 32-bit counter running at the full system clock. It overflows
+// after roughly 256 seconds.
+type PerformanceCounter struct {
+	operations int
+}
+
+// Start resets the operation count and restarts the cycle counter.
+func (p *PerformanceCounter) Start() {
+	p.operations = 0
+
+	Timer2.Stop()
+	Timer3.Stop()
+	Timer2.SetReload(0)
+	Timer3.SetReload(0)
+	Timer2.DisableCascade()
+	Timer2.SetPrescaler(PrescalerF1)
+	Timer3.EnableCascade()
+	Timer3.Start()
+	Timer2.Start()
+}
+
+// Elapsed returns the number of cycles since Start.
+func (p *PerformanceCounter) Elapsed() uint32 {
+	for {
+		high := Timer3.Value()
+		low := Timer2.Value()
+		// Retry if the low half overflowed between the two reads.
+		if Timer3.Value() == high {
+			return uint32(high)<<16 | uint32(low)
+		}
+	}
+}
+
+// AddOperation counts one completed operation.
+func (p *PerformanceCounter) AddOperation() {
+	p.operations++
+}
+
+// GetOperations returns the number of operations counted since Start.
+func (p *PerformanceCounter) GetOperations() int {
+	return p.operations
+}
+
+// CyclesPerOperation returns the average cycle cost of an operation, or 0
+// if none were counted.
+func (p *PerformanceCounter) CyclesPerOperation() float32 {
+	if p.operations == 0 {
+		return 0
+	}
+	return float32(p.Elapsed()) / float32(p.operations)
+}
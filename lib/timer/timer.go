@@ -0,0 +1,108 @@
+package timer
+
+import (
+	"errors"
+	"runtime/volatile"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// Prescaler divides the 16.78MHz system clock before it reaches a timer.
+type Prescaler uint16
+
+const (
+	PrescalerF1 Prescaler = iota
+	PrescalerF64
+	PrescalerF256
+	PrescalerF1024
+)
+
+// ClockFrequency is the GBA system clock in Hz.
+const ClockFrequency = 1 << 24
+
+const (
+	prescalerMask = 0b11
+	cascadeBit    = 1 << 2
+	startBit      = 1 << 7
+)
+
+var (
+	ErrCascadeTimer0    = errors.New("timer: timer 0 cannot cascade")
+	ErrInvalidFrequency = errors.New("timer: frequency out of range")
+)
+
+var prescalerDivs = [...]int{1, 64, 256, 1024}
+
+type Timer struct {
+	counter *volatile.Register16 // Counter on read, reload value on write
+	control *volatile.Register16
+	index   int
+}
+
+var (
+	Timer0 = &Timer{counter: registers.Timer.TM0CNT_L, control: registers.Timer.TM0CNT_H, index: 0}
+	Timer1 = &Timer{counter: registers.Timer.TM1CNT_L, control: registers.Timer.TM1CNT_H, index: 1}
+	Timer2 = &Timer{counter: registers.Timer.TM2CNT_L, control: registers.Timer.TM2CNT_H, index: 2}
+	Timer3 = &Timer{counter: registers.Timer.TM3CNT_L, control: registers.Timer.TM3CNT_H, index: 3}
+)
+
+// SetReload sets the value the counter restarts from on start and on every
+// overflow.
+func (t *Timer) SetReload(value uint16) {
+	t.counter.Set(value)
+}
+
+// SetPrescaler selects the clock divider. It is ignored while cascading.
+func (t *Timer) SetPrescaler(p Prescaler) {
+	t.control.ReplaceBits(uint16(p), prescalerMask, 0)
+}
+
+// EnableCascade makes the timer count overflows of the previous timer
+// instead of clock ticks. Timer 0 has no previous timer.
+func (t *Timer) EnableCascade() error {
+	if t.index == 0 {
+		return ErrCascadeTimer0
+	}
+	t.control.SetBits(cascadeBit)
+	return nil
+}
+
+// DisableCascade makes the timer count prescaled clock ticks again.
+func (t *Timer) DisableCascade() {
+	t.control.ClearBits(cascadeBit)
+}
+
+// Start reloads the counter and starts counting.
+func (t *Timer) Start() {
+	t.control.SetBits(startBit)
+}
+
+// Stop halts the counter.
+func (t *Timer) Stop() {
+	t.control.ClearBits(startBit)
+}
+
+// Value returns the current counter value.
+func (t *Timer) Value() uint16 {
+	return t.counter.Get()
+}
+
+// SetFrequency picks the smallest prescaler and a reload value so that the
+// timer overflows approximately hz times per second.
+func (t *Timer) SetFrequency(hz int) error {
+	if hz <= 0 || hz > ClockFrequency {
+		return ErrInvalidFrequency
+	}
+	for i, div := range prescalerDivs {
+		ticks := (ClockFrequency/div + hz/2) / hz
+		if ticks == 0 {
+			return ErrInvalidFrequency
+		}
+		if ticks <= 0x10000 {
+			t.SetPrescaler(Prescaler(i))
+			t.SetReload(uint16(0x10000 - ticks))
+			return nil
+		}
+	}
+	return ErrInvalidFrequency
+}
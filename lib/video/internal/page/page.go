@@ -0,0 +1,22 @@
+// Package page does the DISPCNT page-select arithmetic of modes 4 and 5.
+// It has no hardware dependencies so it can be tested on the host.
+package page
+
+// Bit is the page-select bit of DISPCNT, registers.DISPCNTPage.
+const Bit = 1 << 4
+
+// Displayed returns the page (0 or 1) that dispcnt shows.
+func Displayed(dispcnt uint16) int {
+	return int(dispcnt&Bit) >> 4
+}
+
+// Back returns the page that dispcnt does not show.
+func Back(dispcnt uint16) int {
+	return Displayed(dispcnt) ^ 1
+}
+
+// Flip returns dispcnt showing the other page, with every other field
+// unchanged.
+func Flip(dispcnt uint16) uint16 {
+	return dispcnt ^ Bit
+}
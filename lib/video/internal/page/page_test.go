@@ -0,0 +1,21 @@
+package page
+
+import "testing"
+
+func TestFlipTracksPages(t *testing.T) {
+	const other = 0x1F43 // mode 3, 1D OBJ mapping, BG0-3 and OBJ on
+	reg := uint16(other)
+	for i := 0; i < 5; i++ {
+		want := i % 2
+		if got := Displayed(reg); got != want {
+			t.Fatalf("flip %d: Displayed = %d, want %d", i, got, want)
+		}
+		if got := Back(reg); got != want^1 {
+			t.Fatalf("flip %d: Back = %d, want %d", i, got, want^1)
+		}
+		if reg&^Bit != other {
+			t.Fatalf("flip %d: other fields changed: %#x", i, reg)
+		}
+		reg = Flip(reg)
+	}
+}
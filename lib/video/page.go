@@ -0,0 +1,27 @@
+package video
+
+import (
+	"github.com/matheusmortatti/gba-go/lib/registers"
+	"github.com/matheusmortatti/gba-go/lib/video/internal/page"
+)
+
+// Modes 4 and 5 have two frame buffers. DISPCNT bit 4 selects the one
+// being displayed and is the only record of which page is current, so
+// every page flip should go through Flip.
+
+// DisplayedPage returns the page (0 or 1) currently shown.
+func DisplayedPage() int {
+	return page.Displayed(registers.Lcd.DISPCNT.Get())
+}
+
+// BackPage returns the page that is not shown, which is the one to draw
+// into.
+func BackPage() int {
+	return page.Back(registers.Lcd.DISPCNT.Get())
+}
+
+// Flip shows the back page, making the previously displayed page the new
+// back page.
+func Flip() {
+	registers.Lcd.DISPCNT.Set(page.Flip(registers.Lcd.DISPCNT.Get()))
+}
@@ -0,0 +1,27 @@
+package video
+
+import (
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+const (
+	vblankFlag = 1 << 0
+	hblankFlag = 1 << 1
+)
+
+// InVBlank reports whether the display is in the vertical blank, lines
+// 160-227.
+func InVBlank() bool {
+	return registers.Lcd.DISPSTAT.HasBits(vblankFlag)
+}
+
+// InHBlank reports whether the display is in the horizontal blank of the
+// current line. The flag is also set during VBlank lines.
+func InHBlank() bool {
+	return registers.Lcd.DISPSTAT.HasBits(hblankFlag)
+}
+
+// CurrentScanline returns the line being drawn, 0-227.
+func CurrentScanline() int {
+	return int(registers.Lcd.VCOUNT.Get() & 0xFF)
+}
@@ -0,0 +1,51 @@
+package video
+
+import (
+	"github.com/matheusmortatti/gba-go/lib/bios"
+	"github.com/matheusmortatti/gba-go/lib/interrupts"
+)
+
+var (
+	vblankCallbacks []func()
+	frameCount      uint32
+	vblankEnabled   bool
+)
+
+// VSync sleeps until the next VBlank starts.
+func VSync() {
+	bios.VBlankIntrWait()
+}
+
+// EnableVBlank installs the video package's VBlank interrupt handler,
+// which counts frames and runs the OnVBlank callbacks. It takes over the
+// VBlank interrupt, replacing handlers set through the interrupts package.
+func EnableVBlank() error {
+	if vblankEnabled {
+		return nil
+	}
+	if err := interrupts.EnableInterrupt(interrupts.VBlank, vblankHandler); err != nil {
+		return err
+	}
+	vblankEnabled = true
+	return nil
+}
+
+// OnVBlank registers fn to run at every VBlank, after those registered
+// before it. Callbacks run in interrupt context, so keep them short.
+func OnVBlank(fn func()) error {
+	vblankCallbacks = append(vblankCallbacks, fn)
+	return EnableVBlank()
+}
+
+// FrameCount returns the number of VBlanks since EnableVBlank (or the
+// first OnVBlank) was called.
+func FrameCount() uint32 {
+	return frameCount
+}
+
+func vblankHandler() {
+	frameCount++
+	for _, fn := range vblankCallbacks {
+		fn()
+	}
+}
@@ -0,0 +1,106 @@
+package video
+
+import (
+	"errors"
+
+	"github.com/matheusmortatti/gba-go/lib/registers"
+)
+
+// OBJMapping selects how multi-tile sprites find their tiles in VRAM.
+type OBJMapping uint16
+
+const (
+	// OBJMapping2D treats sprite VRAM as a 32x32 tile sheet.
+	OBJMapping2D OBJMapping = 0
+	// OBJMapping1D stores the tiles of each sprite one after another.
+	OBJMapping1D OBJMapping = 1
+)
+
+const maxMode = 5
+
+var ErrInvalidMode = errors.New("video: mode must be 0-5")
+
+// DisplayConfig describes the contents of DISPCNT.
+type DisplayConfig struct {
+	Mode           int
+	EnableBG0      bool
+	EnableBG1      bool
+	EnableBG2      bool
+	EnableBG3      bool
+	EnableOBJ      bool
+	OBJVRAMMapping OBJMapping
+	ForcedBlank    bool
+	EnableWin0     bool
+	EnableWin1     bool
+	EnableOBJWin   bool
+}
+
+// Apply writes the configuration to DISPCNT. The displayed page of modes 4
+// and 5 is left as it is.
+func (c DisplayConfig) Apply() error {
+	if c.Mode < 0 || c.Mode > maxMode {
+		return ErrInvalidMode
+	}
+	v := uint16(c.Mode)
+	for i, on := range [...]bool{c.EnableBG0, c.EnableBG1, c.EnableBG2, c.EnableBG3, c.EnableOBJ} {
+		if on {
+			v |= registers.DISPCNTBG0 << i
+		}
+	}
+	if c.OBJVRAMMapping == OBJMapping1D {
+		v |= registers.DISPCNTOBJMapping
+	}
+	if c.ForcedBlank {
+		v |= registers.DISPCNTForcedBlank
+	}
+	if c.EnableWin0 {
+		v |= registers.DISPCNTWin0
+	}
+	if c.EnableWin1 {
+		v |= registers.DISPCNTWin1
+	}
+	if c.EnableOBJWin {
+		v |= registers.DISPCNTOBJWin
+	}
+
+	page := registers.Lcd.DISPCNT.Get() & registers.DISPCNTPage
+	registers.Lcd.DISPCNT.Set(v | page)
+	return nil
+}
+
+// SetMode changes the video mode, keeping the other DISPCNT settings.
+func SetMode(mode int) error {
+	if mode < 0 || mode > maxMode {
+		return ErrInvalidMode
+	}
+	registers.Lcd.SetMode(mode)
+	return nil
+}
+
+// ForceBlank turns forced blank on or off. While it is on the screen shows
+// white and the CPU can access VRAM, OAM and palette RAM at full speed.
+func ForceBlank(on bool) {
+	registers.Lcd.SetForcedBlank(on)
+}
+
+// WithForcedBlank runs fn with forced blank on, e.g. to load graphics
+// during a loading screen. The screen stays white until fn returns.
+func WithForcedBlank(fn func()) {
+	ForceBlank(true)
+	defer ForceBlank(false)
+	fn()
+}
+
+// SetOBJMapping selects 1D or 2D sprite tile mapping, keeping the other
+// DISPCNT settings.
+func SetOBJMapping(m OBJMapping) {
+	registers.Lcd.SetOBJMapping(m == OBJMapping1D)
+}
+
+// CurrentOBJMapping returns the sprite tile mapping set in DISPCNT.
+func CurrentOBJMapping() OBJMapping {
+	if registers.Lcd.DISPCNT.HasBits(registers.DISPCNTOBJMapping) {
+		return OBJMapping1D
+	}
+	return OBJMapping2D
+}